@@ -2,14 +2,13 @@ package api
 
 import (
 	"database/sql"
-	"errors"
-	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/lib/pq"
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/token"
+	"github.com/scipiia/snippetbox/util"
 )
 
 type createAccountRequest struct {
@@ -20,7 +19,7 @@ type createAccountRequest struct {
 func (server *Server) createAccount(ctx *gin.Context) {
 	var req createAccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
@@ -34,13 +33,12 @@ func (server *Server) createAccount(ctx *gin.Context) {
 
 	account, err := server.query.CreateAccount(ctx, arg)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			log.Println(pqError.Code.Name())
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditAccountCreated, util.AuditTarget("account", account.ID), nil)
+
 	ctx.JSON(http.StatusOK, account)
 }
 
@@ -51,24 +49,13 @@ type getAccountRequest struct {
 func (server *Server) getAccount(ctx *gin.Context) {
 	var req getAccountRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
-	account, err := server.query.GetAccount(ctx, req.ID)
+	account, err := server.authorizeAccount(ctx, req.ID, util.OrgRoleViewer)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Login != authPayload.Name {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
@@ -82,16 +69,25 @@ type deleteAccountRequest struct {
 func (server *Server) deleteAccount(ctx *gin.Context) {
 	var req deleteAccountRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
-	err := server.query.DeleteAccount(ctx, req.ID)
+	_, err := server.authorizeAccount(ctx, req.ID, util.OrgRoleOwner)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
+	err = server.query.DeleteAccount(ctx, req.ID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditAccountDeleted, util.AuditTarget("account", req.ID), nil)
+
 	ctx.JSON(http.StatusOK, nil)
 }
 
@@ -103,7 +99,13 @@ type updateAccountRequest struct {
 func (server *Server) updateAccount(ctx *gin.Context) {
 	var req updateAccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
+		return
+	}
+
+	_, err := server.authorizeAccount(ctx, req.ID, util.OrgRoleOwner)
+	if err != nil {
+		writeError(ctx, err)
 		return
 	}
 
@@ -114,9 +116,38 @@ func (server *Server) updateAccount(ctx *gin.Context) {
 
 	user, err := server.query.UpdateAccount(ctx, arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditAccountUpdated, util.AuditTarget("account", user.ID), gin.H{
+		"username": user.Username,
+	})
+
 	ctx.JSON(http.StatusOK, user)
 }
+
+// authorizeAccount returns the account if the authenticated user has at least
+// the required role on it, the owner of a personal account has every role.
+func (server *Server) authorizeAccount(ctx *gin.Context, accountID int32, required string) (db.Account, error) {
+	account, err := server.query.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, apperr.NotFound("ACCOUNT_NOT_FOUND", "account not found")
+		}
+		return account, err
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	role, err := db.AccountRole(ctx, server.query, account, authPayload.Name)
+	if err != nil {
+		return account, err
+	}
+
+	if !util.HasOrgRole(role, required) {
+		return account, apperr.PermissionDenied("ACCOUNT_ACCESS_DENIED", "not allowed to access the snippets of the account")
+	}
+
+	return account, nil
+}
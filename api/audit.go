@@ -0,0 +1,35 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+// recordAuditEvent appends an event to the audit log. The action itself has
+// already happened, so failures are only logged.
+func (server *Server) recordAuditEvent(ctx *gin.Context, actor string, action string, target string, metadata gin.H) {
+	if metadata == nil {
+		metadata = gin.H{}
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		log.Printf("cannot marshal %s audit event: %v", action, err)
+		return
+	}
+
+	_, err = server.query.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		ClientIp:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+		Metadata:  data,
+	})
+	if err != nil {
+		log.Printf("cannot record %s audit event: %v", action, err)
+	}
+}
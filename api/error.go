@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/apperr"
+)
+
+func init() {
+	// validation errors name the JSON fields, not the Go ones
+	if validate, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			for _, tag := range []string{"json", "uri", "form"} {
+				if name := strings.Split(field.Tag.Get(tag), ",")[0]; name != "" && name != "-" {
+					return name
+				}
+			}
+			return field.Name
+		})
+	}
+}
+
+type fieldViolationResponse struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// the body of every error, like the details of the gRPC errors
+type errorResponseBody struct {
+	Error  string                   `json:"error"`
+	Reason string                   `json:"reason"`
+	Fields []fieldViolationResponse `json:"fields,omitempty"`
+}
+
+func errorResponse(appErr *apperr.Error) errorResponseBody {
+	body := errorResponseBody{
+		Error:  appErr.Message,
+		Reason: appErr.Reason,
+	}
+	for _, field := range appErr.Fields {
+		body.Fields = append(body.Fields, fieldViolationResponse{
+			Field:       field.Field,
+			Description: field.Description,
+		})
+	}
+	return body
+}
+
+// writeError aborts the request with the status of the domain error of err,
+// the cause of internal errors is only logged.
+func writeError(ctx *gin.Context, err error) {
+	appErr := apperr.Map(err)
+	if appErr.Kind == apperr.KindInternal || appErr.Kind == apperr.KindUnavailable {
+		log.Ctx(ctx.Request.Context()).Error().Err(err).Str("reason", appErr.Reason).Msg("request failed")
+	}
+
+	ctx.AbortWithStatusJSON(appErr.Kind.HTTPStatus(), errorResponse(appErr))
+}
+
+// bindingError lists the invalid fields of a request that cannot be bound.
+func bindingError(err error) *apperr.Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]apperr.FieldViolation, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			description := "must satisfy " + fieldErr.Tag()
+			if fieldErr.Param() != "" {
+				description += "=" + fieldErr.Param()
+			}
+			fields = append(fields, apperr.FieldViolation{
+				Field:       fieldErr.Field(),
+				Description: description,
+			})
+		}
+		return apperr.Validation(fields...).WithCause(err)
+	}
+
+	return apperr.Validation(apperr.FieldViolation{
+		Field:       "body",
+		Description: "malformed request",
+	}).WithCause(err)
+}
+
+func unauthenticatedError(err error) *apperr.Error {
+	return apperr.Unauthenticated("UNAUTHENTICATED", fmt.Sprintf("unauthorized: %s", err))
+}
@@ -1,11 +1,13 @@
 package api
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/stretchr/testify/require"
@@ -23,6 +25,29 @@ func NewTestServer(t *testing.T, store db.Store) *Server {
 	return server
 }
 
+type eqAuditEventMatcher struct {
+	actor  string
+	action string
+	target string
+}
+
+func (e eqAuditEventMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(db.CreateAuditEventParams)
+	if !ok {
+		return false
+	}
+
+	return arg.Actor == e.actor && arg.Action == e.action && arg.Target == e.target
+}
+
+func (e eqAuditEventMatcher) String() string {
+	return fmt.Sprintf("is audit event %s by %s on %s", e.action, e.actor, e.target)
+}
+
+func EqAuditEvent(actor string, action string, target string) gomock.Matcher {
+	return eqAuditEventMatcher{actor, action, target}
+}
+
 func TestMain(m *testing.M) {
 
 	gin.SetMode(gin.TestMode)
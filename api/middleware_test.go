@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/scipiia/snippetbox/token"
+	"github.com/scipiia/snippetbox/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,7 +21,7 @@ func addAuthorization(
 	name string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(name, duration)
+	token, payload, err := tokenMaker.CreateToken(name, util.UserRole, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
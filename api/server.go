@@ -45,14 +45,16 @@ func (server *Server) setupRouter() {
 	//account
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccount)
-	router.DELETE("/accounts/:id", server.deleteAccount)
-	router.PATCH("/accounts", server.updateAccount)
+	authRoutes.DELETE("/accounts/:id", server.deleteAccount)
+	authRoutes.PATCH("/accounts", server.updateAccount)
 
 	//snippets
-	router.POST("/accounts/snippet", server.createSnippet)
-	router.GET("/accounts/snippet/:id", server.getSnippet)
-	router.GET("/accounts/snippet", server.listSnippets)
-	router.DELETE("/accounts/snippet/:id", server.deleteSnippet)
+	authRoutes.POST("/accounts/snippet", server.createSnippet)
+	authRoutes.GET("/accounts/snippet/:id", server.getSnippet)
+	authRoutes.GET("/accounts/snippet/:id/raw/:filename", server.getSnippetFile)
+	authRoutes.GET("/accounts/snippet", server.listSnippets)
+	authRoutes.PATCH("/accounts/snippet/:id", server.updateSnippet)
+	authRoutes.DELETE("/accounts/snippet/:id", server.deleteSnippet)
 
 	server.router = router
 }
@@ -60,7 +62,3 @@ func (server *Server) setupRouter() {
 func (server *Server) Start(adress string) error {
 	return server.router.Run(adress)
 }
-
-func errorResponse(err error) gin.H {
-	return gin.H{"error": err.Error()}
-}
@@ -2,43 +2,81 @@ package api
 
 import (
 	"database/sql"
-	"log"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/lib/pq"
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/token"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
 )
 
+type snippetFileRequest struct {
+	Filename string `json:"filename" binding:"required"`
+	Language string `json:"language"`
+	Content  string `json:"content" binding:"required"`
+}
+
 type createSnippetRequest struct {
-	AccountID int32  `json:"account_id" binding:"required"`
-	Title     string `json:"title" binding:"required"`
-	Content   string `json:"content" binding:"required"`
+	AccountID int32                `json:"account_id" binding:"required"`
+	Title     string               `json:"title" binding:"required"`
+	Language  string               `json:"language"`
+	IsPublic  bool                 `json:"is_public"`
+	Files     []snippetFileRequest `json:"files" binding:"required,dive"`
+}
+
+// snippetResponse is a snippet with its files in order
+type snippetResponse struct {
+	db.Snippet
+	Files []db.SnippetFile `json:"files"`
 }
 
 func (server *Server) createSnippet(ctx *gin.Context) {
 	var req createSnippetRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
-	arg := db.CreateSnippetParams{
-		AccountID: req.AccountID,
-		Title:     req.Title,
-		Content:   req.Content,
+	files, err := snippetFileParams(req.Files)
+	if err != nil {
+		writeError(ctx, err)
+		return
 	}
 
-	account, err := server.query.CreateSnippet(ctx, arg)
+	account, err := server.authorizeAccount(ctx, req.AccountID, util.OrgRoleMember)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			log.Println(pqError.Code.Name())
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	arg := db.CreateSnippetTxParams{
+		CreateSnippetParams: db.CreateSnippetParams{
+			AccountID: account.ID,
+			Title:     req.Title,
+			Language:  req.Language,
+			IsPublic:  req.IsPublic,
+		},
+		Files: files,
+	}
+
+	result, err := server.query.CreateSnippetTx(ctx, arg)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	snippet := result.Snippet
+	server.publishSnippetEvent(ctx, util.EventSnippetCreated, account, snippet)
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditSnippetCreated, util.AuditTarget("snippet", snippet.ID), gin.H{
+		"account_id": snippet.AccountID,
+	})
+
+	ctx.JSON(http.StatusOK, snippetResponse{Snippet: snippet, Files: result.Files})
 }
 
 type getSnippetRequest struct {
@@ -48,51 +86,204 @@ type getSnippetRequest struct {
 func (server *Server) getSnippet(ctx *gin.Context) {
 	var req getSnippetRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
+		return
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, req.ID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	files, err := server.query.ListSnippetFiles(ctx, snippet.ID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, snippetResponse{Snippet: snippet, Files: files})
+}
+
+type getSnippetFileRequest struct {
+	ID       int32  `uri:"id" binding:"required,min=1"`
+	Filename string `uri:"filename" binding:"required"`
+}
+
+// getSnippetFile answers with the raw content of one file of a snippet
+func (server *Server) getSnippetFile(ctx *gin.Context) {
+	var req getSnippetFileRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		writeError(ctx, bindingError(err))
 		return
 	}
 
-	user, err := server.query.GetSnippet(ctx, req.ID)
+	snippet, err := server.getReadableSnippet(ctx, req.ID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	file, err := server.query.GetSnippetFile(ctx, db.GetSnippetFileParams{
+		SnippetID: snippet.ID,
+		Filename:  req.Filename,
+	})
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			writeError(ctx, apperr.NotFound("SNIPPET_FILE_NOT_FOUND", "snippet file not found"))
 			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, user)
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(file.Content))
 }
 
 type listSnippetsRequest struct {
-	AccountID int32 `form:"account_id" binding:"required"`
-	PageID    int32 `form:"page_id" binding:"required,min=1"`
-	PageSize  int32 `form:"page_size" binding:"required,min=5,max=10"`
+	AccountID    int32 `form:"account_id" binding:"required"`
+	CollectionID int64 `form:"collection_id" binding:"omitempty,min=1"`
+	PageID       int32 `form:"page_id" binding:"required,min=1"`
+	PageSize     int32 `form:"page_size" binding:"required,min=5,max=10"`
 }
 
+// listSnippets lists the snippets of the account by id, or the snippets of
+// one of its collections in the order of the collection.
 func (server *Server) listSnippets(ctx *gin.Context) {
 	var req listSnippetsRequest
 	if err := ctx.ShouldBindQuery(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
+		return
+	}
+
+	account, err := server.authorizeAccount(ctx, req.AccountID, util.OrgRoleViewer)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	if req.CollectionID != 0 {
+		server.listCollectionSnippets(ctx, account, req)
 		return
 	}
 
 	arg := db.ListSnippetsParams{
-		AccountID: req.AccountID,
+		AccountID: account.ID,
 		Limit:     req.PageSize,
 		Offset:    (req.PageID - 1) * req.PageSize,
 	}
 
 	snippets, err := server.query.ListSnippets(ctx, arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, snippets)
 }
 
+func (server *Server) listCollectionSnippets(ctx *gin.Context, account db.Account, req listSnippetsRequest) {
+	collection, err := server.query.GetCollection(ctx, req.CollectionID)
+	if err != nil && err != sql.ErrNoRows {
+		writeError(ctx, err)
+		return
+	}
+	if err == sql.ErrNoRows || collection.AccountID != account.ID {
+		writeError(ctx, apperr.NotFound("COLLECTION_NOT_FOUND", "collection not found"))
+		return
+	}
+
+	arg := db.ListSnippetsByCollectionParams{
+		CollectionID: collection.ID,
+		Limit:        req.PageSize,
+		Offset:       (req.PageID - 1) * req.PageSize,
+	}
+
+	snippets, err := server.query.ListSnippetsByCollection(ctx, arg)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, snippets)
+}
+
+type updateSnippetUri struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type updateSnippetRequest struct {
+	Title    *string `json:"title" binding:"omitempty,min=1"`
+	Language *string `json:"language"`
+	IsPublic *bool   `json:"is_public"`
+	// Files replace all files of the snippet when given
+	Files []snippetFileRequest `json:"files" binding:"omitempty,dive"`
+}
+
+func (server *Server) updateSnippet(ctx *gin.Context) {
+	var uri updateSnippetUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		writeError(ctx, bindingError(err))
+		return
+	}
+
+	var req updateSnippetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		writeError(ctx, bindingError(err))
+		return
+	}
+
+	arg := db.UpdateSnippetTxParams{
+		UpdateSnippetParams: db.UpdateSnippetParams{
+			ID: uri.ID,
+		},
+	}
+	if req.Title != nil {
+		arg.Title = sql.NullString{String: *req.Title, Valid: true}
+	}
+	if req.Language != nil {
+		arg.Language = sql.NullString{String: *req.Language, Valid: true}
+	}
+	if req.IsPublic != nil {
+		arg.IsPublic = sql.NullBool{Bool: *req.IsPublic, Valid: true}
+	}
+	if req.Files != nil {
+		files, err := snippetFileParams(req.Files)
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		arg.Files = files
+	}
+
+	account, _, err := server.authorizeSnippet(ctx, uri.ID, util.OrgRoleMember)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	result, err := server.query.UpdateSnippetTx(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeError(ctx, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found"))
+			return
+		}
+		writeError(ctx, err)
+		return
+	}
+
+	snippet := result.Snippet
+	server.publishSnippetEvent(ctx, util.EventSnippetUpdated, account, snippet)
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditSnippetUpdated, util.AuditTarget("snippet", snippet.ID), gin.H{
+		"account_id": snippet.AccountID,
+	})
+
+	ctx.JSON(http.StatusOK, snippetResponse{Snippet: snippet, Files: result.Files})
+}
+
 type deleteSnippetRequest struct {
 	ID int32 `uri:"id" binding:"required,min=1"`
 }
@@ -100,15 +291,113 @@ type deleteSnippetRequest struct {
 func (server *Server) deleteSnippet(ctx *gin.Context) {
 	var req deleteSnippetRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
-	err := server.query.DeleteSnippet(ctx, req.ID)
+	account, _, err := server.authorizeSnippet(ctx, req.ID, util.OrgRoleMember)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
+	snippet, err := server.query.DeleteSnippet(ctx, req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeError(ctx, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found"))
+			return
+		}
+		writeError(ctx, err)
+		return
+	}
+
+	server.publishSnippetEvent(ctx, util.EventSnippetDeleted, account, snippet)
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditSnippetDeleted, util.AuditTarget("snippet", snippet.ID), gin.H{
+		"account_id": snippet.AccountID,
+	})
+
 	ctx.JSON(http.StatusOK, nil)
 }
+
+// authorizeSnippet returns the snippet and its account if the authenticated
+// user has at least the required role on the account.
+func (server *Server) authorizeSnippet(ctx *gin.Context, id int32, required string) (db.Account, db.Snippet, error) {
+	snippet, err := server.query.GetSnippet(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Account{}, snippet, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+		}
+		return db.Account{}, snippet, err
+	}
+
+	account, err := server.authorizeAccount(ctx, snippet.AccountID, required)
+	if err != nil {
+		return account, snippet, err
+	}
+
+	return account, snippet, nil
+}
+
+// getReadableSnippet returns the snippet if it is public or the authenticated
+// user can read the snippets of its account.
+func (server *Server) getReadableSnippet(ctx *gin.Context, id int32) (db.Snippet, error) {
+	snippet, err := server.query.GetSnippet(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return snippet, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+		}
+		return snippet, err
+	}
+
+	if snippet.IsPublic {
+		return snippet, nil
+	}
+
+	_, err = server.authorizeAccount(ctx, snippet.AccountID, util.OrgRoleViewer)
+	if err != nil {
+		return snippet, err
+	}
+
+	return snippet, nil
+}
+
+// snippetFileParams validates the files of a request, their languages default
+// to the one of the file extension.
+func snippetFileParams(files []snippetFileRequest) ([]db.SnippetFileParams, error) {
+	var fields []apperr.FieldViolation
+	if err := validation.ValidateSnippetFileCount(len(files)); err != nil {
+		fields = append(fields, apperr.FieldViolation{Field: "files", Description: err.Error()})
+	}
+
+	params := make([]db.SnippetFileParams, 0, len(files))
+	seen := make(map[string]bool, len(files))
+	for i, file := range files {
+		if err := validation.ValidateSnippetFilename(file.Filename); err != nil {
+			fields = append(fields, apperr.FieldViolation{Field: fmt.Sprintf("files[%d].filename", i), Description: err.Error()})
+		} else if seen[file.Filename] {
+			fields = append(fields, apperr.FieldViolation{Field: fmt.Sprintf("files[%d].filename", i), Description: "must be unique in the snippet"})
+		}
+		seen[file.Filename] = true
+
+		if err := validation.ValidateSnippetContent(file.Content); err != nil {
+			fields = append(fields, apperr.FieldViolation{Field: fmt.Sprintf("files[%d].content", i), Description: err.Error()})
+		}
+
+		language := file.Language
+		if language == "" {
+			language = util.LanguageFromFilename(file.Filename)
+		}
+		params = append(params, db.SnippetFileParams{
+			Filename: file.Filename,
+			Language: language,
+			Content:  file.Content,
+		})
+	}
+
+	if len(fields) > 0 {
+		return nil, apperr.Validation(fields...)
+	}
+	return params, nil
+}
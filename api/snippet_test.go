@@ -9,21 +9,27 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	mockdb "github.com/scipiia/snippetbox/db/mock"
 	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/token"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/stretchr/testify/require"
 )
 
 func TestCreateSbippetAPI(t *testing.T) {
-	snippet := randomSnippet()
+	user, _ := createRandomUser(t)
+	account := randomAccount(user.Name)
+	snippet := randomSnippet(account.ID)
+	files := randomSnippetFiles(snippet.ID)
 
 	testCases := []struct {
 		name          string
 		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
@@ -32,21 +38,60 @@ func TestCreateSbippetAPI(t *testing.T) {
 			body: gin.H{
 				"account_id": snippet.AccountID,
 				"title":      snippet.Title,
-				"content":    snippet.Content,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				arg := db.CreateSnippetParams{
-					AccountID: snippet.AccountID,
-					Title:     snippet.Title,
-					Content:   snippet.Content,
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.CreateSnippetTxParams{
+					CreateSnippetParams: db.CreateSnippetParams{
+						AccountID: snippet.AccountID,
+						Title:     snippet.Title,
+					},
+					Files: snippetFileArgs(files),
 				}
 
 				store.EXPECT().
-					CreateSnippet(gomock.Any(), gomock.Eq(arg)).Times(1).Return(snippet, nil)
+					CreateSnippetTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateSnippetTxResult{Snippet: snippet, Files: files}, nil)
+				store.EXPECT().
+					CreateOutboxTask(gomock.Any(), gomock.Any()).Times(1).Return(db.Outbox{}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditSnippetCreated, util.AuditTarget("snippet", snippet.ID))).
+					Times(1).Return(db.AuditEvent{}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchSnippet(t, recorder.Body, snippet)
+				requireBodyMatchSnippet(t, recorder.Body, snippet, files)
+			},
+		},
+		{
+			name: "PublishEventError",
+			body: gin.H{
+				"account_id": snippet.AccountID,
+				"title":      snippet.Title,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateSnippetTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateSnippetTxResult{Snippet: snippet, Files: files}, nil)
+				store.EXPECT().
+					CreateOutboxTask(gomock.Any(), gomock.Any()).Times(1).Return(db.Outbox{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditSnippetCreated, util.AuditTarget("snippet", snippet.ID))).
+					Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippet(t, recorder.Body, snippet, files)
 			},
 		},
 		{
@@ -54,17 +99,25 @@ func TestCreateSbippetAPI(t *testing.T) {
 			body: gin.H{
 				"account_id": snippet.AccountID,
 				"title":      snippet.Title,
-				"content":    snippet.Content,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				arg := db.CreateSnippetParams{
-					AccountID: snippet.AccountID,
-					Title:     snippet.Title,
-					Content:   snippet.Content,
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.CreateSnippetTxParams{
+					CreateSnippetParams: db.CreateSnippetParams{
+						AccountID: snippet.AccountID,
+						Title:     snippet.Title,
+					},
+					Files: snippetFileArgs(files),
 				}
 
 				store.EXPECT().
-					CreateSnippet(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.Snippet{}, sql.ErrConnDone)
+					CreateSnippetTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateSnippetTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -75,7 +128,10 @@ func TestCreateSbippetAPI(t *testing.T) {
 			body: gin.H{
 				"account_id": 0,
 				"title":      snippet.Title,
-				"content":    snippet.Content,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -85,6 +141,79 @@ func TestCreateSbippetAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "BadRequestDuplicateFilename",
+			body: gin.H{
+				"account_id": snippet.AccountID,
+				"title":      snippet.Title,
+				"files":      snippetFilesBody(append(files, files[0])),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BadRequestNoFiles",
+			body: gin.H{
+				"account_id": snippet.AccountID,
+				"title":      snippet.Title,
+				"files":      []gin.H{},
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Forbidden",
+			body: gin.H{
+				"account_id": snippet.AccountID,
+				"title":      snippet.Title,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: gin.H{
+				"account_id": snippet.AccountID,
+				"title":      snippet.Title,
+				"files":      snippetFilesBody(files),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -108,6 +237,7 @@ func TestCreateSbippetAPI(t *testing.T) {
 			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
 			require.NoError(t, err)
 
+			tc.setupAuth(t, request, server.tokenMaker)
 			server.router.ServeHTTP(recorder, request)
 
 			tc.checkResponse(t, recorder)
@@ -116,28 +246,46 @@ func TestCreateSbippetAPI(t *testing.T) {
 }
 
 func TestGetSnippetAPI(t *testing.T) {
-	snippet := randomSnippet()
+	user, _ := createRandomUser(t)
+	account := randomAccount(user.Name)
+	snippet := randomSnippet(account.ID)
+	files := randomSnippetFiles(snippet.ID)
+
+	orgAccount := account
+	orgAccount.OrganizationID = sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true}
+
+	public := snippet
+	public.IsPublic = true
 
 	testCases := []struct {
 		name          string
 		snippetId     int32
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
 			name:      "OK",
 			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListSnippetFiles(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(files, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchSnippet(t, recorder.Body, snippet)
+				requireBodyMatchSnippet(t, recorder.Body, snippet, files)
 			},
 		},
 		{
 			name:      "NotFound",
 			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(db.Snippet{}, sql.ErrNoRows)
 			},
@@ -148,6 +296,9 @@ func TestGetSnippetAPI(t *testing.T) {
 		{
 			name:      "InternalError",
 			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(db.Snippet{}, sql.ErrConnDone)
 			},
@@ -158,6 +309,9 @@ func TestGetSnippetAPI(t *testing.T) {
 		{
 			name:      "BadRequestInvalidID",
 			snippetId: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetSnippet(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -165,6 +319,70 @@ func TestGetSnippetAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name:      "OrganizationViewer",
+			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(orgAccount, nil)
+				store.EXPECT().
+					GetOrganizationMember(gomock.Any(), gomock.Eq(db.GetOrganizationMemberParams{
+						OrganizationID: orgAccount.OrganizationID.Int64,
+						Username:       "viewer",
+					})).
+					Times(1).Return(db.OrganizationMember{Role: util.OrgRoleViewer}, nil)
+				store.EXPECT().ListSnippetFiles(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(files, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippet(t, recorder.Body, snippet, files)
+			},
+		},
+		{
+			name:      "Forbidden",
+			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:      "NoAuthorization",
+			snippetId: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Public",
+			snippetId: public.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "other_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(public.ID)).Times(1).Return(public, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListSnippetFiles(gomock.Any(), gomock.Eq(public.ID)).Times(1).Return(files, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippet(t, recorder.Body, public, files)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -184,6 +402,357 @@ func TestGetSnippetAPI(t *testing.T) {
 			request, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetSnippetFileAPI(t *testing.T) {
+	user, _ := createRandomUser(t)
+	account := randomAccount(user.Name)
+	snippet := randomSnippet(account.ID)
+	file := randomSnippetFiles(snippet.ID)[0]
+
+	public := snippet
+	public.IsPublic = true
+
+	testCases := []struct {
+		name          string
+		filename      string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			filename: file.Filename,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetSnippetFile(gomock.Any(), gomock.Eq(db.GetSnippetFileParams{
+						SnippetID: snippet.ID,
+						Filename:  file.Filename,
+					})).
+					Times(1).Return(file, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Equal(t, file.Content, recorder.Body.String())
+			},
+		},
+		{
+			name:     "FileNotFound",
+			filename: "missing.go",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetSnippetFile(gomock.Any(), gomock.Any()).
+					Times(1).Return(db.SnippetFile{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Forbidden",
+			filename: file.Filename,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetSnippetFile(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Public",
+			filename: file.Filename,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "other_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSnippet(gomock.Any(), gomock.Eq(public.ID)).Times(1).Return(public, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetSnippetFile(gomock.Any(), gomock.Any()).Times(1).Return(file, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, file.Content, recorder.Body.String())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			store := mockdb.NewMockStore(controller)
+			tc.buildStubs(store)
+
+			server := NewTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/snippet/%d/raw/%s", snippet.ID, tc.filename)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateSnippetAPI(t *testing.T) {
+	user, _ := createRandomUser(t)
+	account := randomAccount(user.Name)
+	snippet := randomSnippet(account.ID)
+
+	orgAccount := account
+	orgAccount.OrganizationID = sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true}
+	newTitle := util.RandomString(10)
+
+	updated := snippet
+	updated.Title = newTitle
+	files := randomSnippetFiles(snippet.ID)
+	newFiles := randomSnippetFiles(snippet.ID)
+
+	testCases := []struct {
+		name          string
+		snippetID     int32
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.UpdateSnippetTxParams{
+					UpdateSnippetParams: db.UpdateSnippetParams{
+						Title: sql.NullString{String: newTitle, Valid: true},
+						ID:    snippet.ID,
+					},
+				}
+
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.UpdateSnippetTxResult{Snippet: updated, Files: files}, nil)
+				store.EXPECT().
+					CreateOutboxTask(gomock.Any(), gomock.Any()).Times(1).Return(db.Outbox{}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditSnippetUpdated, util.AuditTarget("snippet", snippet.ID))).
+					Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippet(t, recorder.Body, updated, files)
+			},
+		},
+		{
+			name:      "Files",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"files": snippetFilesBody(newFiles),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.UpdateSnippetTxParams{
+					UpdateSnippetParams: db.UpdateSnippetParams{
+						ID: snippet.ID,
+					},
+					Files: snippetFileArgs(newFiles),
+				}
+
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.UpdateSnippetTxResult{Snippet: snippet, Files: newFiles}, nil)
+				store.EXPECT().
+					CreateOutboxTask(gomock.Any(), gomock.Any()).Times(1).Return(db.Outbox{}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditSnippetUpdated, util.AuditTarget("snippet", snippet.ID))).
+					Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippet(t, recorder.Body, snippet, newFiles)
+			},
+		},
+		{
+			name:      "BadRequestInvalidFilename",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"files": []gin.H{{"filename": "dir/main.go", "content": "package main"}},
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(db.Snippet{}, sql.ErrNoRows)
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(1).Return(db.UpdateSnippetTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:      "BadRequestEmptyTitle",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"title": "",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "BadRequestInvalidID",
+			snippetID: 0,
+			body: gin.H{
+				"title": newTitle,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "OrganizationViewer",
+			snippetID: snippet.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(orgAccount, nil)
+				store.EXPECT().
+					GetOrganizationMember(gomock.Any(), gomock.Any()).
+					Times(1).Return(db.OrganizationMember{Role: util.OrgRoleViewer}, nil)
+				store.EXPECT().
+					UpdateSnippetTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			store := mockdb.NewMockStore(controller)
+			tc.buildStubs(store)
+
+			server := NewTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/snippet/%d", tc.snippetID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
 			server.router.ServeHTTP(recorder, request)
 
 			tc.checkResponse(t, recorder)
@@ -192,20 +761,35 @@ func TestGetSnippetAPI(t *testing.T) {
 }
 
 func TestDeleteSnippetAPI(t *testing.T) {
-	snippet := randomSnippet()
+	user, _ := createRandomUser(t)
+	account := randomAccount(user.Name)
+	snippet := randomSnippet(account.ID)
 
 	testCases := []struct {
 		name          string
 		snippetID     int32
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
 			name:      "OK",
 			snippetID: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(nil)
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					DeleteSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					CreateOutboxTask(gomock.Any(), gomock.Any()).Times(1).Return(db.Outbox{}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditSnippetDeleted, util.AuditTarget("snippet", snippet.ID))).
+					Times(1).Return(db.AuditEvent{}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -214,17 +798,43 @@ func TestDeleteSnippetAPI(t *testing.T) {
 		{
 			name:      "InternalError",
 			snippetID: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(sql.ErrConnDone)
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					DeleteSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(db.Snippet{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name:      "NotFound",
+			snippetID: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(db.Snippet{}, sql.ErrNoRows)
+				store.EXPECT().
+					DeleteSnippet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
 		{
 			name:      "BadRequestInvalidID",
 			snippetID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					DeleteSnippet(gomock.Any(), gomock.Any()).Times(0)
@@ -233,6 +843,24 @@ func TestDeleteSnippetAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name:      "Forbidden",
+			snippetID: snippet.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSnippet(gomock.Any(), gomock.Eq(snippet.ID)).Times(1).Return(snippet, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					DeleteSnippet(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -252,6 +880,7 @@ func TestDeleteSnippetAPI(t *testing.T) {
 			request, err := http.NewRequest(http.MethodDelete, url, nil)
 			require.NoError(t, err)
 
+			tc.setupAuth(t, request, server.tokenMaker)
 			server.router.ServeHTTP(recorder, request)
 
 			tc.checkResponse(t, recorder)
@@ -262,22 +891,29 @@ func TestDeleteSnippetAPI(t *testing.T) {
 func TestListSnippets(t *testing.T) {
 	user, _ := createRandomUser(t)
 	account := randomAccount(user.Name)
+	collection := db.Collection{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Name:      util.RandomString(8),
+	}
 
 	n := 5
 	snippets := make([]db.Snippet, n)
 	for i := 0; i < n; i++ {
-		snippets[i] = randomSnippet()
+		snippets[i] = randomSnippet(account.ID)
 	}
 
 	type Query struct {
-		accountID int
-		pageID    int
-		pageSize  int
+		accountID    int
+		collectionID int
+		pageID       int
+		pageSize     int
 	}
 
 	testCases := []struct {
 		name          string
 		query         Query
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
@@ -288,12 +924,17 @@ func TestListSnippets(t *testing.T) {
 				pageID:    1,
 				pageSize:  n,
 			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				arg := db.ListSnippetsParams{
 					AccountID: int32(account.ID),
 					Limit:     int32(n),
 					Offset:    0,
 				}
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
 				store.EXPECT().
 					ListSnippets(gomock.Any(), gomock.Eq(arg)).
 					Times(1).Return(snippets, nil)
@@ -310,7 +951,12 @@ func TestListSnippets(t *testing.T) {
 				pageID:    1,
 				pageSize:  n,
 			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
 				store.EXPECT().
 					ListSnippets(gomock.Any(), gomock.Any()).
 					Times(1).Return([]db.Snippet{}, sql.ErrConnDone)
@@ -326,6 +972,9 @@ func TestListSnippets(t *testing.T) {
 				pageID:    -1,
 				pageSize:  n,
 			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListSnippets(gomock.Any(), gomock.Any()).
@@ -342,6 +991,9 @@ func TestListSnippets(t *testing.T) {
 				pageID:    1,
 				pageSize:  100000,
 			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListSnippets(gomock.Any(), gomock.Any()).
@@ -351,6 +1003,87 @@ func TestListSnippets(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "AccountNotFound",
+			query: Query{
+				accountID: int(account.ID),
+				pageID:    1,
+				pageSize:  n,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListSnippets(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Collection",
+			query: Query{
+				accountID:    int(account.ID),
+				collectionID: int(collection.ID),
+				pageID:       1,
+				pageSize:     n,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListSnippetsByCollectionParams{
+					CollectionID: collection.ID,
+					Limit:        int32(n),
+					Offset:       0,
+				}
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetCollection(gomock.Any(), gomock.Eq(collection.ID)).Times(1).Return(collection, nil)
+				store.EXPECT().
+					ListSnippetsByCollection(gomock.Any(), gomock.Eq(arg)).
+					Times(1).Return(snippets, nil)
+				store.EXPECT().
+					ListSnippets(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSnippets(t, recorder.Body, snippets)
+			},
+		},
+		{
+			name: "CollectionOfOtherAccount",
+			query: Query{
+				accountID:    int(account.ID),
+				collectionID: int(collection.ID),
+				pageID:       1,
+				pageSize:     n,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Name, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				other := collection
+				other.AccountID = account.ID + 1
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetCollection(gomock.Any(), gomock.Eq(collection.ID)).Times(1).Return(other, nil)
+				store.EXPECT().
+					ListSnippetsByCollection(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -373,10 +1106,14 @@ func TestListSnippets(t *testing.T) {
 			// Add query parameters to request URL
 			q := request.URL.Query()
 			q.Add("account_id", fmt.Sprintf("%d", tc.query.accountID))
+			if tc.query.collectionID != 0 {
+				q.Add("collection_id", fmt.Sprintf("%d", tc.query.collectionID))
+			}
 			q.Add("page_id", fmt.Sprintf("%d", tc.query.pageID))
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
 			request.URL.RawQuery = q.Encode()
 
+			tc.setupAuth(t, request, server.tokenMaker)
 			server.router.ServeHTTP(recorder, request)
 
 			tc.checkResponse(t, recorder)
@@ -384,23 +1121,63 @@ func TestListSnippets(t *testing.T) {
 	}
 }
 
-func randomSnippet() db.Snippet {
+func randomSnippet(accountID int32) db.Snippet {
 	return db.Snippet{
 		ID:        int32(util.RandomInt(1, 1000)),
-		AccountID: int32(util.RandomInt(1, 1000)),
+		AccountID: accountID,
 		Title:     util.RandomString(5),
-		Content:   util.RandomString(10),
 	}
 }
 
-func requireBodyMatchSnippet(t *testing.T, body *bytes.Buffer, snippet db.Snippet) {
+func randomSnippetFiles(snippetID int32) []db.SnippetFile {
+	files := make([]db.SnippetFile, 2)
+	for i := range files {
+		files[i] = db.SnippetFile{
+			ID:        util.RandomInt(1, 1000),
+			SnippetID: snippetID,
+			Filename:  util.RandomString(6) + ".go",
+			Language:  "go",
+			Content:   util.RandomString(10),
+			Position:  int32(i + 1),
+		}
+	}
+	return files
+}
+
+// snippetFilesBody lists the files of a request, their language is left to
+// the extension
+func snippetFilesBody(files []db.SnippetFile) []gin.H {
+	body := make([]gin.H, 0, len(files))
+	for _, file := range files {
+		body = append(body, gin.H{
+			"filename": file.Filename,
+			"content":  file.Content,
+		})
+	}
+	return body
+}
+
+func snippetFileArgs(files []db.SnippetFile) []db.SnippetFileParams {
+	args := make([]db.SnippetFileParams, 0, len(files))
+	for _, file := range files {
+		args = append(args, db.SnippetFileParams{
+			Filename: file.Filename,
+			Language: file.Language,
+			Content:  file.Content,
+		})
+	}
+	return args
+}
+
+func requireBodyMatchSnippet(t *testing.T, body *bytes.Buffer, snippet db.Snippet, files []db.SnippetFile) {
 	data, err := ioutil.ReadAll(body)
 	require.NoError(t, err)
 
-	var gotSnippet db.Snippet
+	var gotSnippet snippetResponse
 	err = json.Unmarshal(data, &gotSnippet)
 	require.NoError(t, err)
-	require.Equal(t, snippet, gotSnippet)
+	require.Equal(t, snippet, gotSnippet.Snippet)
+	require.Equal(t, files, gotSnippet.Files)
 }
 
 func requireBodyMatchSnippets(t *testing.T, body *bytes.Buffer, snippets []db.Snippet) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/scipiia/snippetbox/apperr"
 )
 
 type renewAccessTokenRequest struct {
@@ -21,57 +22,58 @@ type renewAccessTokenResponse struct {
 func (server *Server) renewAccessTokenReques(ctx *gin.Context) {
 	var req renewAccessTokenRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
 	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, unauthenticatedError(err))
 		return
 	}
 
 	session, err := server.query.GetSession(ctx, refreshPayload.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			writeError(ctx, apperr.NotFound("SESSION_NOT_FOUND", "session not found"))
 			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
 	if session.IsBlocked {
 		err := fmt.Errorf("blocked session")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, unauthenticatedError(err))
 		return
 	}
 
 	if session.Name != refreshPayload.Name {
 		err := fmt.Errorf("incorrect session user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, unauthenticatedError(err))
 		return
 	}
 
 	if session.RefreshToken != req.RefreshToken {
 		err := fmt.Errorf("mismatched session tocken")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, unauthenticatedError(err))
 		return
 	}
 
 	if time.Now().After(session.ExpiresAt) {
 		err := fmt.Errorf("expired session")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		writeError(ctx, unauthenticatedError(err))
 		return
 	}
 
 	//access token
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		refreshPayload.Name,
+		refreshPayload.Role,
 		server.config.AccessTokenDuration,
 	)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
@@ -2,13 +2,12 @@ package api
 
 import (
 	"database/sql"
-	"log"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/lib/pq"
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/util"
 )
@@ -42,13 +41,13 @@ func newUserPesponse(user db.User) userResponce {
 func (server *Server) createUser(ctx *gin.Context) {
 	var req createUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
 	hashedPassword, err := util.HashedPassword(req.Password)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
@@ -61,15 +60,11 @@ func (server *Server) createUser(ctx *gin.Context) {
 
 	user, err := server.query.CreateUser(ctx, arg)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			log.Println(pqError.Code.Name())
-			switch pqError.Code.Name() {
-			case "unique_violation":
-				ctx.JSON(http.StatusForbidden, errorResponse(err))
-				return
-			}
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			writeError(ctx, apperr.AlreadyExists("USER_ALREADY_EXISTS", "name or email already exists").WithCause(err))
+			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
@@ -95,43 +90,53 @@ type loginUserResponse struct {
 func (server *Server) loginUser(ctx *gin.Context) {
 	var req loginUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, bindingError(err))
 		return
 	}
 
 	user, err := server.query.GetUser(ctx, req.Name)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			// the name is chosen by the caller, it may be registered later
+			server.recordAuditEvent(ctx, util.AnonymousActor, util.AuditUserLoginFailed, "", gin.H{
+				"reason": "user_not_found",
+				"name":   req.Name,
+			})
+			writeError(ctx, apperr.NotFound("USER_NOT_FOUND", "user not found"))
 			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		server.recordAuditEvent(ctx, util.AnonymousActor, util.AuditUserLoginFailed, util.AuditTarget("user", user.Name), gin.H{
+			"reason": "incorrect_password",
+		})
+		writeError(ctx, apperr.Unauthenticated("INCORRECT_PASSWORD", "incorrect password"))
 		return
 	}
 
 	//access token
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Name,
+		user.Role,
 		server.config.AccessTokenDuration,
 	)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
 	//refresh token
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Name,
+		user.Role,
 		server.config.RefreshTokenDuration,
 	)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
@@ -145,10 +150,14 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ExpiresAt:    refreshPayload.ExpiredAt,
 	})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, err)
 		return
 	}
 
+	server.recordAuditEvent(ctx, user.Name, util.AuditUserLogin, util.AuditTarget("user", user.Name), gin.H{
+		"session_id": session.ID,
+	})
+
 	rsp := loginUserResponse{
 		SessionID:             session.ID,
 		AccessToken:           accessToken,
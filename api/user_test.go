@@ -111,7 +111,8 @@ func TestCreateUser(t *testing.T) {
 					Times(1).Return(db.User{}, &pq.Error{Code: "23505"})
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"reason":"USER_ALREADY_EXISTS"`)
 			},
 		},
 		{
@@ -211,6 +212,9 @@ func TestLoginUserAPI(t *testing.T) {
 					Times(1).Return(user, nil)
 				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).
 					Times(1)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(user.Name, util.AuditUserLogin, util.AuditTarget("user", user.Name))).
+					Times(1).Return(db.AuditEvent{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -227,6 +231,9 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(util.AnonymousActor, util.AuditUserLoginFailed, "")).
+					Times(1).Return(db.AuditEvent{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -243,6 +250,9 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Eq(user.Name)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), EqAuditEvent(util.AnonymousActor, util.AuditUserLoginFailed, util.AuditTarget("user", user.Name))).
+					Times(1).Return(db.AuditEvent{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
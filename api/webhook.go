@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/worker"
+)
+
+// publishSnippetEvent queues a webhook event for the login of the account of
+// the snippet. Failures are only logged, the snippet change itself has
+// already succeeded.
+func (server *Server) publishSnippetEvent(ctx context.Context, event string, account db.Account, snippet db.Snippet) {
+	data, err := json.Marshal(snippet)
+	if err != nil {
+		log.Printf("cannot marshal %s event: %v", event, err)
+		return
+	}
+
+	payload := &worker.PayloadDispatchWebhookEvent{
+		Event:      event,
+		Owner:      account.Login,
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+
+	distributor := worker.NewOutboxTaskDistributor(server.query)
+	err = worker.DistributeTaskDispatchWebhookEvent(ctx, distributor, payload)
+	if err != nil {
+		log.Printf("cannot publish %s event: %v", event, err)
+	}
+}
@@ -0,0 +1,208 @@
+// Package apperr defines the errors of the domain, which both transports
+// render the same way: a kind for the status code, a stable reason for
+// programs and a message that is safe to show. The cause is only logged.
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+)
+
+// Domain qualifies the reasons in error details.
+const Domain = "snippetbox"
+
+type Kind uint8
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindAlreadyExists
+	KindPermissionDenied
+	KindUnauthenticated
+	KindConflict
+	KindFailedPrecondition
+	KindRateLimited
+	KindCanceled
+	KindUnavailable
+)
+
+var kindNames = map[Kind]string{
+	KindInternal:           "internal",
+	KindValidation:         "validation",
+	KindNotFound:           "not_found",
+	KindAlreadyExists:      "already_exists",
+	KindPermissionDenied:   "permission_denied",
+	KindUnauthenticated:    "unauthenticated",
+	KindConflict:           "conflict",
+	KindFailedPrecondition: "failed_precondition",
+	KindRateLimited:        "rate_limited",
+	KindCanceled:           "canceled",
+	KindUnavailable:        "unavailable",
+}
+
+func (kind Kind) String() string {
+	if name, ok := kindNames[kind]; ok {
+		return name
+	}
+	return kindNames[KindInternal]
+}
+
+// reasons of the errors without a more specific one
+const (
+	ReasonInternal         = "INTERNAL"
+	ReasonValidationFailed = "VALIDATION_FAILED"
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonCanceled         = "CANCELED"
+	ReasonDeadlineExceeded = "DEADLINE_EXCEEDED"
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type Error struct {
+	Kind Kind
+	// stable and machine-readable, like USER_NOT_FOUND
+	Reason string
+	// shown to clients, it must not contain internals
+	Message string
+	// the invalid fields of a validation error
+	Fields []FieldViolation
+
+	cause error
+}
+
+func (err *Error) Error() string {
+	if err.cause != nil {
+		return err.Message + ": " + err.cause.Error()
+	}
+	return err.Message
+}
+
+func (err *Error) Unwrap() error {
+	return err.cause
+}
+
+// WithCause returns a copy of the error wrapping cause, for the logs.
+func (err *Error) WithCause(cause error) *Error {
+	withCause := *err
+	withCause.cause = cause
+	return &withCause
+}
+
+func New(kind Kind, reason string, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func NotFound(reason string, message string) *Error {
+	return New(KindNotFound, reason, message)
+}
+
+func AlreadyExists(reason string, message string) *Error {
+	return New(KindAlreadyExists, reason, message)
+}
+
+func PermissionDenied(reason string, message string) *Error {
+	return New(KindPermissionDenied, reason, message)
+}
+
+func Unauthenticated(reason string, message string) *Error {
+	return New(KindUnauthenticated, reason, message)
+}
+
+func Conflict(reason string, message string) *Error {
+	return New(KindConflict, reason, message)
+}
+
+func FailedPrecondition(reason string, message string) *Error {
+	return New(KindFailedPrecondition, reason, message)
+}
+
+// Validation lists the invalid fields of a request.
+func Validation(fields ...FieldViolation) *Error {
+	err := New(KindValidation, ReasonValidationFailed, "invalid parameters")
+	err.Fields = fields
+	return err
+}
+
+// Internal hides cause from clients.
+func Internal(cause error) *Error {
+	return New(KindInternal, ReasonInternal, "internal error").WithCause(cause)
+}
+
+// Map returns the domain error of err. Domain errors are returned as they
+// are, database and context errors are mapped, and anything else is
+// internal. A nil err stays nil.
+func Map(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return NotFound(ReasonNotFound, "resource not found").WithCause(err)
+	}
+	if errors.Is(err, context.Canceled) {
+		return New(KindCanceled, ReasonCanceled, "request canceled").WithCause(err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return New(KindUnavailable, ReasonDeadlineExceeded, "request timed out").WithCause(err)
+	}
+	if pgErr := fromPostgres(err); pgErr != nil {
+		return pgErr
+	}
+
+	return Internal(err)
+}
+
+// MapNotFound is Map with the not found error to return for no rows.
+func MapNotFound(err error, reason string, message string) *Error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return NotFound(reason, message).WithCause(err)
+	}
+	return Map(err)
+}
+
+// KindOf returns the kind of the domain error of err.
+func KindOf(err error) Kind {
+	return Map(err).Kind
+}
+
+// HTTPStatus is the status code of the kind, the same the gateway uses for
+// the gRPC code of the kind.
+func (kind Kind) HTTPStatus() int {
+	switch kind {
+	case KindValidation, KindFailedPrecondition:
+		return http.StatusBadRequest
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindAlreadyExists, KindConflict:
+		return http.StatusConflict
+	case KindPermissionDenied:
+		return http.StatusForbidden
+	case KindUnauthenticated:
+		return http.StatusUnauthorized
+	case KindRateLimited:
+		return http.StatusTooManyRequests
+	case KindCanceled:
+		// the client closed the request, as in nginx
+		return 499
+	case KindUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,77 @@
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMap(t *testing.T) {
+	notFound := NotFound("USER_NOT_FOUND", "user not found")
+
+	testCases := []struct {
+		name   string
+		err    error
+		kind   Kind
+		reason string
+	}{
+		{"Domain", fmt.Errorf("wrapped: %w", notFound), KindNotFound, "USER_NOT_FOUND"},
+		{"NoRows", fmt.Errorf("failed to get user: %w", sql.ErrNoRows), KindNotFound, ReasonNotFound},
+		{"UniqueViolation", &pq.Error{Code: "23505"}, KindAlreadyExists, ReasonAlreadyExists},
+		{"ForeignKeyViolation", fmt.Errorf("failed to create snippet: %w", &pq.Error{Code: "23503"}), KindConflict, ReasonReferenceConflict},
+		{"CheckViolation", &pq.Error{Code: "23514"}, KindValidation, ReasonValidationFailed},
+		{"SerializationFailure", &pq.Error{Code: "40001"}, KindConflict, ReasonConcurrentUpdate},
+		{"OtherPostgres", &pq.Error{Code: "42P01"}, KindInternal, ReasonInternal},
+		{"Canceled", context.Canceled, KindCanceled, ReasonCanceled},
+		{"DeadlineExceeded", context.DeadlineExceeded, KindUnavailable, ReasonDeadlineExceeded},
+		{"Other", errors.New("connection reset"), KindInternal, ReasonInternal},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			appErr := Map(tc.err)
+			require.Equal(t, tc.kind, appErr.Kind)
+			require.Equal(t, tc.reason, appErr.Reason)
+			require.Equal(t, tc.kind, KindOf(tc.err))
+		})
+	}
+
+	require.Nil(t, Map(nil))
+}
+
+func TestInternalHidesCause(t *testing.T) {
+	cause := &pq.Error{Code: "42P01", Message: `relation "users" does not exist`}
+	appErr := Map(cause)
+
+	require.Equal(t, "internal error", appErr.Message)
+	require.NotContains(t, appErr.Message, "users")
+	require.ErrorIs(t, appErr, cause)
+	require.Contains(t, appErr.Error(), "does not exist")
+}
+
+func TestMapNotFound(t *testing.T) {
+	appErr := MapNotFound(sql.ErrNoRows, "EXPORT_NOT_FOUND", "export not found")
+	require.Equal(t, KindNotFound, appErr.Kind)
+	require.Equal(t, "EXPORT_NOT_FOUND", appErr.Reason)
+
+	appErr = MapNotFound(errors.New("boom"), "EXPORT_NOT_FOUND", "export not found")
+	require.Equal(t, KindInternal, appErr.Kind)
+}
+
+func TestHTTPStatus(t *testing.T) {
+	require.Equal(t, http.StatusBadRequest, KindValidation.HTTPStatus())
+	require.Equal(t, http.StatusNotFound, KindNotFound.HTTPStatus())
+	require.Equal(t, http.StatusConflict, KindAlreadyExists.HTTPStatus())
+	require.Equal(t, http.StatusConflict, KindConflict.HTTPStatus())
+	require.Equal(t, http.StatusForbidden, KindPermissionDenied.HTTPStatus())
+	require.Equal(t, http.StatusUnauthorized, KindUnauthenticated.HTTPStatus())
+	require.Equal(t, http.StatusInternalServerError, KindInternal.HTTPStatus())
+}
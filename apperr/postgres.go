@@ -0,0 +1,40 @@
+package apperr
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// reasons of database errors
+const (
+	ReasonReferenceConflict = "REFERENCE_CONFLICT"
+	ReasonConcurrentUpdate  = "CONCURRENT_UPDATE"
+	ReasonDatabaseBusy      = "DATABASE_UNAVAILABLE"
+)
+
+// fromPostgres maps the Postgres error codes a request can cause, other
+// database errors stay internal.
+func fromPostgres(err error) *Error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return nil
+	}
+
+	switch pqErr.Code.Name() {
+	case "unique_violation":
+		return AlreadyExists(ReasonAlreadyExists, "resource already exists").WithCause(err)
+	case "foreign_key_violation":
+		// a referenced row is missing, or the row is still referenced
+		return Conflict(ReasonReferenceConflict, "resource is referenced by or references a missing resource").WithCause(err)
+	case "not_null_violation", "check_violation", "string_data_right_truncation",
+		"numeric_value_out_of_range", "invalid_text_representation":
+		return New(KindValidation, ReasonValidationFailed, "invalid parameters").WithCause(err)
+	case "serialization_failure", "deadlock_detected", "lock_not_available":
+		return Conflict(ReasonConcurrentUpdate, "resource was changed concurrently, retry").WithCause(err)
+	case "too_many_connections", "admin_shutdown", "cannot_connect_now":
+		return New(KindUnavailable, ReasonDatabaseBusy, "service temporarily unavailable").WithCause(err)
+	}
+
+	return nil
+}
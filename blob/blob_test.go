@@ -0,0 +1,56 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	content := util.RandomString(100)
+
+	size, err := store.Put(ctx, "exports/1.zip", strings.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), size)
+
+	r, err := store.Open(ctx, "exports/1.zip")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, content, string(data))
+
+	require.NoError(t, store.Delete(ctx, "exports/1.zip"))
+	require.NoError(t, store.Delete(ctx, "exports/1.zip"))
+
+	_, err = store.Open(ctx, "exports/1.zip")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = store.Put(ctx, "../outside", strings.NewReader(content))
+	require.Error(t, err)
+}
+
+func TestURLSigner(t *testing.T) {
+	signer := NewURLSigner(util.RandomString(32))
+	expires := time.Now().Add(time.Minute)
+
+	signature := signer.Sign("1", expires)
+	require.NoError(t, signer.Verify("1", expires, signature))
+	require.ErrorIs(t, signer.Verify("2", expires, signature), ErrInvalidSignature)
+	require.ErrorIs(t, signer.Verify("1", expires.Add(time.Second), signature), ErrInvalidSignature)
+
+	other := NewURLSigner(util.RandomString(32))
+	require.ErrorIs(t, other.Verify("1", expires, signature), ErrInvalidSignature)
+
+	expired := time.Now().Add(-time.Minute)
+	require.ErrorIs(t, signer.Verify("1", expired, signer.Sign("1", expired)), ErrExpiredSignature)
+}
@@ -0,0 +1,48 @@
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidSignature = errors.New("signature is invalid")
+	ErrExpiredSignature = errors.New("signature has expired")
+)
+
+// URLSigner signs download links, a link is valid until it expires
+type URLSigner struct {
+	secret []byte
+}
+
+func NewURLSigner(secret string) *URLSigner {
+	return &URLSigner{
+		secret: []byte(secret),
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the resource and expiry time.
+func (signer *URLSigner) Sign(resource string, expires time.Time) string {
+	mac := hmac.New(sha256.New, signer.secret)
+	mac.Write([]byte(resource))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strconv.FormatInt(expires.Unix(), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (signer *URLSigner) Verify(resource string, expires time.Time, signature string) error {
+	expected := signer.Sign(resource, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	if time.Now().After(expires) {
+		return ErrExpiredSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL DEFAULT 'default',
+  "max_retry" integer NOT NULL DEFAULT 25,
+  "process_at" timestamptz,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "max_attempts" integer NOT NULL DEFAULT 20,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT now(),
+  "last_error" varchar,
+  "published_at" timestamptz,
+  "dead_at" timestamptz,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ON "outbox" ("next_attempt_at") WHERE "published_at" IS NULL AND "dead_at" IS NULL;
+
+CREATE INDEX ON "outbox" ("published_at");
@@ -0,0 +1,30 @@
+CREATE TABLE "webhooks" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "events" varchar[] NOT NULL,
+  "secret" varchar NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "failure_count" integer NOT NULL DEFAULT 0,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "webhook_id" bigint NOT NULL,
+  "event" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempt" integer NOT NULL,
+  "status_code" integer,
+  "error" varchar,
+  "succeeded" boolean NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ON "webhooks" ("owner");
+
+CREATE INDEX ON "webhook_deliveries" ("webhook_id");
+
+ALTER TABLE "webhooks" ADD FOREIGN KEY ("owner") REFERENCES "users" ("name");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "snippet_imports";
+
+DROP INDEX IF EXISTS "snippets_account_id_title_idx";
+
+ALTER TABLE "snippets" DROP COLUMN IF EXISTS "language";
\ No newline at end of file
@@ -0,0 +1,27 @@
+ALTER TABLE "snippets" ADD COLUMN "language" varchar NOT NULL DEFAULT '';
+
+CREATE TABLE "snippet_imports" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "account_id" integer NOT NULL,
+  "format" varchar NOT NULL,
+  "duplicate_policy" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "archive" bytea,
+  "total" integer NOT NULL DEFAULT 0,
+  "created_count" integer NOT NULL DEFAULT 0,
+  "updated_count" integer NOT NULL DEFAULT 0,
+  "skipped_count" integer NOT NULL DEFAULT 0,
+  "failed_count" integer NOT NULL DEFAULT 0,
+  "errors" jsonb NOT NULL DEFAULT '[]',
+  "created" timestamptz NOT NULL DEFAULT now(),
+  "finished_at" timestamptz
+);
+
+CREATE INDEX ON "snippet_imports" ("owner");
+
+CREATE INDEX ON "snippets" ("account_id", "title");
+
+ALTER TABLE "snippet_imports" ADD FOREIGN KEY ("owner") REFERENCES "users" ("name");
+
+ALTER TABLE "snippet_imports" ADD FOREIGN KEY ("account_id") REFERENCES "account" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE "exports" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "blob_key" varchar,
+  "size" bigint NOT NULL DEFAULT 0,
+  "error" varchar,
+  "created" timestamptz NOT NULL DEFAULT now(),
+  "finished_at" timestamptz,
+  "expires_at" timestamptz
+);
+
+CREATE INDEX ON "exports" ("owner");
+
+CREATE INDEX ON "exports" ("expires_at");
+
+ALTER TABLE "exports" ADD FOREIGN KEY ("owner") REFERENCES "users" ("name");
\ No newline at end of file
@@ -0,0 +1,50 @@
+CREATE TABLE "organizations" (
+  "id" bigserial PRIMARY KEY,
+  "name" varchar UNIQUE NOT NULL,
+  "display_name" varchar NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "organization_members" (
+  "organization_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("organization_id", "username")
+);
+
+CREATE TABLE "organization_invitations" (
+  "id" bigserial PRIMARY KEY,
+  "organization_id" bigint NOT NULL,
+  "invitee" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "invited_by" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "created" timestamptz NOT NULL DEFAULT now(),
+  "expires_at" timestamptz NOT NULL,
+  "responded_at" timestamptz
+);
+
+CREATE INDEX ON "organization_members" ("username");
+
+CREATE INDEX ON "organization_invitations" ("invitee");
+
+-- a user has at most one pending invitation per organization
+CREATE UNIQUE INDEX ON "organization_invitations" ("organization_id", "invitee") WHERE "status" = 'pending';
+
+ALTER TABLE "organization_members" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "organization_members" ADD FOREIGN KEY ("username") REFERENCES "users" ("name");
+
+ALTER TABLE "organization_invitations" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "organization_invitations" ADD FOREIGN KEY ("invitee") REFERENCES "users" ("name");
+
+ALTER TABLE "organization_invitations" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("name");
+
+-- an account owned by an organization keeps the user who created it in login
+ALTER TABLE "account" ADD COLUMN "organization_id" bigint;
+
+CREATE INDEX ON "account" ("organization_id");
+
+ALTER TABLE "account" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id");
\ No newline at end of file
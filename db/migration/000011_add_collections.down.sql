@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "collection_snippets";
+
+DROP TABLE IF EXISTS "collections";
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE TABLE "collections" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" integer NOT NULL,
+  "parent_id" bigint,
+  "name" varchar NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "collection_snippets" (
+  "collection_id" bigint NOT NULL,
+  "snippet_id" integer NOT NULL,
+  "position" integer NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("collection_id", "snippet_id")
+);
+
+CREATE INDEX ON "collections" ("account_id");
+
+CREATE INDEX ON "collections" ("parent_id");
+
+-- sibling collections have distinct names, top level ones have no parent
+CREATE UNIQUE INDEX ON "collections" ("account_id", COALESCE("parent_id", 0), "name");
+
+CREATE INDEX ON "collection_snippets" ("collection_id", "position");
+
+CREATE INDEX ON "collection_snippets" ("snippet_id");
+
+ALTER TABLE "collections" ADD FOREIGN KEY ("account_id") REFERENCES "account" ("id") ON DELETE CASCADE;
+
+-- deleting a collection deletes its sub collections, never the snippets
+ALTER TABLE "collections" ADD FOREIGN KEY ("parent_id") REFERENCES "collections" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "collection_snippets" ADD FOREIGN KEY ("collection_id") REFERENCES "collections" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "collection_snippets" ADD FOREIGN KEY ("snippet_id") REFERENCES "snippets" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "snippet_stars";
+
+ALTER TABLE "snippets" DROP COLUMN IF EXISTS "forked_from";
+
+ALTER TABLE "snippets" DROP COLUMN IF EXISTS "is_public";
\ No newline at end of file
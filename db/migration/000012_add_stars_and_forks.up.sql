@@ -0,0 +1,21 @@
+ALTER TABLE "snippets" ADD COLUMN "is_public" boolean NOT NULL DEFAULT false;
+
+-- set when the snippet is a fork, kept null once the original is deleted
+ALTER TABLE "snippets" ADD COLUMN "forked_from" integer;
+
+CREATE INDEX ON "snippets" ("forked_from");
+
+ALTER TABLE "snippets" ADD FOREIGN KEY ("forked_from") REFERENCES "snippets" ("id") ON DELETE SET NULL;
+
+CREATE TABLE "snippet_stars" (
+  "username" varchar NOT NULL,
+  "snippet_id" integer NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("username", "snippet_id")
+);
+
+CREATE INDEX ON "snippet_stars" ("snippet_id");
+
+ALTER TABLE "snippet_stars" ADD FOREIGN KEY ("username") REFERENCES "users" ("name") ON DELETE CASCADE;
+
+ALTER TABLE "snippet_stars" ADD FOREIGN KEY ("snippet_id") REFERENCES "snippets" ("id") ON DELETE CASCADE;
\ No newline at end of file
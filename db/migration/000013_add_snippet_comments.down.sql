@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "snippet_comments";
\ No newline at end of file
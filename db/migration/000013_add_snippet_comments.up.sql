@@ -0,0 +1,29 @@
+CREATE TABLE "snippet_comments" (
+  "id" bigserial PRIMARY KEY,
+  "snippet_id" integer NOT NULL,
+  "parent_id" bigint,
+  "author" varchar NOT NULL,
+  "body" varchar NOT NULL,
+  "line_start" integer,
+  "line_end" integer,
+  "created" timestamptz NOT NULL DEFAULT now(),
+  "edited_at" timestamptz,
+  "deleted_at" timestamptz,
+  "deleted_by" varchar
+);
+
+CREATE INDEX ON "snippet_comments" ("snippet_id", "id");
+
+CREATE INDEX ON "snippet_comments" ("parent_id");
+
+-- the anchor is either unset or a 1-based range of lines
+ALTER TABLE "snippet_comments" ADD CONSTRAINT "snippet_comments_line_range" CHECK (
+  ("line_start" IS NULL AND "line_end" IS NULL)
+  OR ("line_start" >= 1 AND "line_end" >= "line_start")
+);
+
+ALTER TABLE "snippet_comments" ADD FOREIGN KEY ("snippet_id") REFERENCES "snippets" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "snippet_comments" ADD FOREIGN KEY ("parent_id") REFERENCES "snippet_comments" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "snippet_comments" ADD FOREIGN KEY ("author") REFERENCES "users" ("name") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE "snippet_comments" DROP COLUMN IF EXISTS "filename";
+
+ALTER TABLE "snippets" ADD COLUMN "content" varchar NOT NULL DEFAULT '';
+
+-- snippets keep the content of their first file
+UPDATE "snippets" s
+SET "content" = f."content"
+FROM "snippet_files" f
+WHERE f."snippet_id" = s."id" AND f."position" = (
+  SELECT min("position") FROM "snippet_files" WHERE "snippet_id" = s."id"
+);
+
+ALTER TABLE "snippets" ALTER COLUMN "content" DROP DEFAULT;
+
+DROP TABLE IF EXISTS "snippet_files";
\ No newline at end of file
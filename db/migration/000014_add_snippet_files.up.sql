@@ -0,0 +1,32 @@
+CREATE TABLE "snippet_files" (
+  "id" bigserial PRIMARY KEY,
+  "snippet_id" integer NOT NULL,
+  "filename" varchar NOT NULL,
+  "language" varchar NOT NULL DEFAULT '',
+  "content" varchar NOT NULL,
+  "position" integer NOT NULL,
+  "created" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX ON "snippet_files" ("snippet_id", "filename");
+
+CREATE INDEX ON "snippet_files" ("snippet_id", "position");
+
+ALTER TABLE "snippet_files" ADD FOREIGN KEY ("snippet_id") REFERENCES "snippets" ("id") ON DELETE CASCADE;
+
+-- every snippet becomes a one-file snippet named after its title
+INSERT INTO "snippet_files" ("snippet_id", "filename", "language", "content", "position", "created")
+SELECT "id", COALESCE(NULLIF(replace("title", '/', '_'), ''), 'snippet'), "language", "content", 1, "created"
+FROM "snippets";
+
+ALTER TABLE "snippets" DROP COLUMN "content";
+
+-- line anchors of comments name the file of the lines
+ALTER TABLE "snippet_comments" ADD COLUMN "filename" varchar;
+
+UPDATE "snippet_comments" c
+SET "filename" = f."filename"
+FROM "snippet_files" f
+WHERE f."snippet_id" = c."snippet_id" AND c."line_start" IS NOT NULL;
+
+ALTER TABLE "snippet_comments" ADD CONSTRAINT "snippet_comments_line_file" CHECK (("line_start" IS NULL) = ("filename" IS NULL));
\ No newline at end of file
@@ -0,0 +1,255 @@
+// Package migration embeds the SQL migrations of the database and runs them.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// the migrations of this directory, for migrate create
+const Dir = "db/migration"
+
+// EmbeddedURL selects the migrations built into the binary, an empty
+// MIGRATION_URL does too.
+const EmbeddedURL = "embed://"
+
+// one instance migrates at a time, the others wait for this lock of the whole
+// command. migrate's own lock gives up after 15 seconds and only covers a
+// single step.
+const advisoryLockID = 7214031988
+
+// OpenSource opens the embedded migrations, or the source of migrationURL
+// like file://db/migration.
+func OpenSource(migrationURL string) (source.Driver, error) {
+	if migrationURL == "" || migrationURL == EmbeddedURL {
+		return iofs.New(files, ".")
+	}
+	return source.Open(migrationURL)
+}
+
+// Migrator runs migrations while holding the migration lock.
+type Migrator struct {
+	db      *sql.DB
+	conn    *sql.Conn
+	migrate *migrate.Migrate
+}
+
+// New waits for the migration lock, until ctx is done.
+func New(ctx context.Context, dbSource string, migrationURL string) (*Migrator, error) {
+	db, err := sql.Open("postgres", dbSource)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot connect to db: %w", err)
+	}
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockID)
+	if err != nil {
+		conn.Close()
+		db.Close()
+		return nil, fmt.Errorf("cannot acquire migration lock: %w", err)
+	}
+
+	migrator := &Migrator{
+		db:   db,
+		conn: conn,
+	}
+
+	sourceDriver, err := OpenSource(migrationURL)
+	if err != nil {
+		migrator.Close()
+		return nil, fmt.Errorf("cannot open migration source: %w", err)
+	}
+
+	dbDriver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		sourceDriver.Close()
+		migrator.Close()
+		return nil, fmt.Errorf("cannot create migration driver: %w", err)
+	}
+
+	migrator.migrate, err = migrate.NewWithInstance("source", sourceDriver, "postgres", dbDriver)
+	if err != nil {
+		sourceDriver.Close()
+		migrator.Close()
+		return nil, fmt.Errorf("cannot create migrate instance: %w", err)
+	}
+
+	return migrator, nil
+}
+
+// Close releases the migration lock.
+func (migrator *Migrator) Close() error {
+	_, err := migrator.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockID)
+	migrator.conn.Close()
+
+	// the migrate instance closes the db too
+	if migrator.migrate != nil {
+		migrator.migrate.Close()
+	} else {
+		migrator.db.Close()
+	}
+	return err
+}
+
+// Up applies n migrations, all pending ones when n is 0.
+func (migrator *Migrator) Up(n int) error {
+	var err error
+	if n > 0 {
+		err = migrator.migrate.Steps(n)
+	} else {
+		err = migrator.migrate.Up()
+	}
+	return ignoreNoChange(err)
+}
+
+// Down rolls back n migrations.
+func (migrator *Migrator) Down(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("number of migrations to roll back must be positive")
+	}
+	return ignoreNoChange(migrator.migrate.Steps(-n))
+}
+
+// Force sets the version without running migrations and clears the dirty
+// flag, after a failed migration was fixed by hand.
+func (migrator *Migrator) Force(version int) error {
+	return migrator.migrate.Force(version)
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
+
+type Status struct {
+	// 0 when no migration is applied
+	Version uint
+	Dirty   bool
+	Latest  uint
+	Pending []uint
+}
+
+// Status compares the applied version with the migrations of the source.
+func (migrator *Migrator) Status(migrationURL string) (Status, error) {
+	var status Status
+
+	version, dirty, err := migrator.migrate.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return status, err
+	}
+	status.Version = version
+	status.Dirty = dirty
+
+	versions, err := Versions(migrationURL)
+	if err != nil {
+		return status, err
+	}
+	for _, v := range versions {
+		if v > status.Version {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+	if len(versions) > 0 {
+		status.Latest = versions[len(versions)-1]
+	}
+
+	return status, nil
+}
+
+// Versions lists the versions of the migrations of the source, in order.
+func Versions(migrationURL string) ([]uint, error) {
+	driver, err := OpenSource(migrationURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open migration source: %w", err)
+	}
+	defer driver.Close()
+
+	version, err := driver.First()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migration source: %w", err)
+	}
+
+	versions := []uint{version}
+	for {
+		next, err := driver.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return versions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read migration source: %w", err)
+		}
+		versions = append(versions, next)
+		version = next
+	}
+}
+
+var (
+	migrationFileName = regexp.MustCompile(`^(\d+)_.*\.(up|down)\.sql$`)
+	migrationName     = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Create adds empty up and down files for the next version to dir, named
+// like 000009_add_comments.up.sql.
+func Create(dir string, name string) (up string, down string, err error) {
+	if !migrationName.MatchString(name) {
+		return "", "", fmt.Errorf("migration name %q must be lower case letters, digits and underscores", name)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	var latest uint64
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err == nil && version > latest {
+			latest = version
+		}
+	}
+
+	base := fmt.Sprintf("%06d_%s", latest+1, strings.TrimSpace(name))
+	up = filepath.Join(dir, base+".up.sql")
+	down = filepath.Join(dir, base+".down.sql")
+
+	for _, file := range []string{up, down} {
+		f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return "", "", err
+		}
+		f.Close()
+	}
+
+	return up, down, nil
+}
@@ -0,0 +1,52 @@
+package migration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedVersions(t *testing.T) {
+	entries, err := os.ReadDir(".")
+	require.NoError(t, err)
+
+	var upFiles int
+	for _, entry := range entries {
+		if match := migrationFileName.FindStringSubmatch(entry.Name()); match != nil && match[2] == "up" {
+			upFiles++
+		}
+	}
+
+	versions, err := Versions(EmbeddedURL)
+	require.NoError(t, err)
+	require.Len(t, versions, upFiles)
+	require.Equal(t, uint(1), versions[0])
+
+	// the same migrations as on disk
+	fileVersions, err := Versions("file://.")
+	require.NoError(t, err)
+	require.Equal(t, fileVersions, versions)
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "000002_add_users.up.sql"), nil, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "000002_add_users.down.sql"), nil, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), nil, 0644))
+
+	up, down, err := Create(dir, "add_comments")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "000003_add_comments.up.sql"), up)
+	require.Equal(t, filepath.Join(dir, "000003_add_comments.down.sql"), down)
+	require.FileExists(t, up)
+	require.FileExists(t, down)
+
+	up, _, err = Create(dir, "add_stars")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "000004_add_stars.up.sql"), up)
+
+	_, _, err = Create(dir, "Add Stars")
+	require.Error(t, err)
+}
@@ -6,7 +6,9 @@ package mockdb
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +38,109 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptOrganizationInvitationTx mocks base method.
+func (m *MockStore) AcceptOrganizationInvitationTx(arg0 context.Context, arg1 int64) (db.AcceptOrganizationInvitationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvitationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AcceptOrganizationInvitationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvitationTx indicates an expected call of AcceptOrganizationInvitationTx.
+func (mr *MockStoreMockRecorder) AcceptOrganizationInvitationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvitationTx", reflect.TypeOf((*MockStore)(nil).AcceptOrganizationInvitationTx), arg0, arg1)
+}
+
+// AddCollectionSnippet mocks base method.
+func (m *MockStore) AddCollectionSnippet(arg0 context.Context, arg1 db.AddCollectionSnippetParams) (db.CollectionSnippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollectionSnippet", arg0, arg1)
+	ret0, _ := ret[0].(db.CollectionSnippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCollectionSnippet indicates an expected call of AddCollectionSnippet.
+func (mr *MockStoreMockRecorder) AddCollectionSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollectionSnippet", reflect.TypeOf((*MockStore)(nil).AddCollectionSnippet), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// ClearStaleSnippetCommentAnchors mocks base method.
+func (m *MockStore) ClearStaleSnippetCommentAnchors(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearStaleSnippetCommentAnchors", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearStaleSnippetCommentAnchors indicates an expected call of ClearStaleSnippetCommentAnchors.
+func (mr *MockStoreMockRecorder) ClearStaleSnippetCommentAnchors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearStaleSnippetCommentAnchors", reflect.TypeOf((*MockStore)(nil).ClearStaleSnippetCommentAnchors), arg0, arg1)
+}
+
+// CopySnippetFiles mocks base method.
+func (m *MockStore) CopySnippetFiles(arg0 context.Context, arg1 db.CopySnippetFilesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySnippetFiles", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopySnippetFiles indicates an expected call of CopySnippetFiles.
+func (mr *MockStoreMockRecorder) CopySnippetFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySnippetFiles", reflect.TypeOf((*MockStore)(nil).CopySnippetFiles), arg0, arg1)
+}
+
+// CountSnippetForks mocks base method.
+func (m *MockStore) CountSnippetForks(arg0 context.Context, arg1 sql.NullInt32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSnippetForks", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSnippetForks indicates an expected call of CountSnippetForks.
+func (mr *MockStoreMockRecorder) CountSnippetForks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSnippetForks", reflect.TypeOf((*MockStore)(nil).CountSnippetForks), arg0, arg1)
+}
+
+// CountSnippetStars mocks base method.
+func (m *MockStore) CountSnippetStars(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSnippetStars", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSnippetStars indicates an expected call of CountSnippetStars.
+func (mr *MockStoreMockRecorder) CountSnippetStars(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSnippetStars", reflect.TypeOf((*MockStore)(nil).CountSnippetStars), arg0, arg1)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(arg0 context.Context, arg1 db.CreateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +156,141 @@ func (mr *MockStoreMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), arg0, arg1)
 }
 
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(arg0 context.Context, arg1 db.CreateAuditEventParams) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), arg0, arg1)
+}
+
+// CreateCollection mocks base method.
+func (m *MockStore) CreateCollection(arg0 context.Context, arg1 db.CreateCollectionParams) (db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", arg0, arg1)
+	ret0, _ := ret[0].(db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockStoreMockRecorder) CreateCollection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockStore)(nil).CreateCollection), arg0, arg1)
+}
+
+// CreateExport mocks base method.
+func (m *MockStore) CreateExport(arg0 context.Context, arg1 string) (db.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExport", arg0, arg1)
+	ret0, _ := ret[0].(db.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExport indicates an expected call of CreateExport.
+func (mr *MockStoreMockRecorder) CreateExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExport", reflect.TypeOf((*MockStore)(nil).CreateExport), arg0, arg1)
+}
+
+// CreateExportTx mocks base method.
+func (m *MockStore) CreateExportTx(arg0 context.Context, arg1 db.CreateExportTxParams) (db.CreateExportTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExportTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateExportTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExportTx indicates an expected call of CreateExportTx.
+func (mr *MockStoreMockRecorder) CreateExportTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportTx", reflect.TypeOf((*MockStore)(nil).CreateExportTx), arg0, arg1)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockStore) CreateOrganization(arg0 context.Context, arg1 db.CreateOrganizationParams) (db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockStoreMockRecorder) CreateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockStore)(nil).CreateOrganization), arg0, arg1)
+}
+
+// CreateOrganizationInvitation mocks base method.
+func (m *MockStore) CreateOrganizationInvitation(arg0 context.Context, arg1 db.CreateOrganizationInvitationParams) (db.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationInvitation indicates an expected call of CreateOrganizationInvitation.
+func (mr *MockStoreMockRecorder) CreateOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).CreateOrganizationInvitation), arg0, arg1)
+}
+
+// CreateOrganizationMember mocks base method.
+func (m *MockStore) CreateOrganizationMember(arg0 context.Context, arg1 db.CreateOrganizationMemberParams) (db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationMember indicates an expected call of CreateOrganizationMember.
+func (mr *MockStoreMockRecorder) CreateOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMember", reflect.TypeOf((*MockStore)(nil).CreateOrganizationMember), arg0, arg1)
+}
+
+// CreateOrganizationTx mocks base method.
+func (m *MockStore) CreateOrganizationTx(arg0 context.Context, arg1 db.CreateOrganizationTxParams) (db.CreateOrganizationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateOrganizationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationTx indicates an expected call of CreateOrganizationTx.
+func (mr *MockStoreMockRecorder) CreateOrganizationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationTx", reflect.TypeOf((*MockStore)(nil).CreateOrganizationTx), arg0, arg1)
+}
+
+// CreateOutboxTask mocks base method.
+func (m *MockStore) CreateOutboxTask(arg0 context.Context, arg1 db.CreateOutboxTaskParams) (db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxTask", arg0, arg1)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxTask indicates an expected call of CreateOutboxTask.
+func (mr *MockStoreMockRecorder) CreateOutboxTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxTask", reflect.TypeOf((*MockStore)(nil).CreateOutboxTask), arg0, arg1)
+}
+
 // CreateSession mocks base method.
 func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +321,96 @@ func (mr *MockStoreMockRecorder) CreateSnippet(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippet", reflect.TypeOf((*MockStore)(nil).CreateSnippet), arg0, arg1)
 }
 
+// CreateSnippetComment mocks base method.
+func (m *MockStore) CreateSnippetComment(arg0 context.Context, arg1 db.CreateSnippetCommentParams) (db.SnippetComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetComment", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetComment indicates an expected call of CreateSnippetComment.
+func (mr *MockStoreMockRecorder) CreateSnippetComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetComment", reflect.TypeOf((*MockStore)(nil).CreateSnippetComment), arg0, arg1)
+}
+
+// CreateSnippetCommentTx mocks base method.
+func (m *MockStore) CreateSnippetCommentTx(arg0 context.Context, arg1 db.CreateSnippetCommentTxParams) (db.CreateSnippetCommentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetCommentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateSnippetCommentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetCommentTx indicates an expected call of CreateSnippetCommentTx.
+func (mr *MockStoreMockRecorder) CreateSnippetCommentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetCommentTx", reflect.TypeOf((*MockStore)(nil).CreateSnippetCommentTx), arg0, arg1)
+}
+
+// CreateSnippetFork mocks base method.
+func (m *MockStore) CreateSnippetFork(arg0 context.Context, arg1 db.CreateSnippetForkParams) (db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetFork", arg0, arg1)
+	ret0, _ := ret[0].(db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetFork indicates an expected call of CreateSnippetFork.
+func (mr *MockStoreMockRecorder) CreateSnippetFork(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetFork", reflect.TypeOf((*MockStore)(nil).CreateSnippetFork), arg0, arg1)
+}
+
+// CreateSnippetImport mocks base method.
+func (m *MockStore) CreateSnippetImport(arg0 context.Context, arg1 db.CreateSnippetImportParams) (db.SnippetImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetImport", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetImport indicates an expected call of CreateSnippetImport.
+func (mr *MockStoreMockRecorder) CreateSnippetImport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetImport", reflect.TypeOf((*MockStore)(nil).CreateSnippetImport), arg0, arg1)
+}
+
+// CreateSnippetImportTx mocks base method.
+func (m *MockStore) CreateSnippetImportTx(arg0 context.Context, arg1 db.CreateSnippetImportTxParams) (db.CreateSnippetImportTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetImportTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateSnippetImportTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetImportTx indicates an expected call of CreateSnippetImportTx.
+func (mr *MockStoreMockRecorder) CreateSnippetImportTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetImportTx", reflect.TypeOf((*MockStore)(nil).CreateSnippetImportTx), arg0, arg1)
+}
+
+// CreateSnippetTx mocks base method.
+func (m *MockStore) CreateSnippetTx(arg0 context.Context, arg1 db.CreateSnippetTxParams) (db.CreateSnippetTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnippetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateSnippetTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnippetTx indicates an expected call of CreateSnippetTx.
+func (mr *MockStoreMockRecorder) CreateSnippetTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnippetTx", reflect.TypeOf((*MockStore)(nil).CreateSnippetTx), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +441,36 @@ func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
 }
 
+// CreateWebhook mocks base method.
+func (m *MockStore) CreateWebhook(arg0 context.Context, arg1 db.CreateWebhookParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockStoreMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockStore)(nil).CreateWebhook), arg0, arg1)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockStore) CreateWebhookDelivery(arg0 context.Context, arg1 db.CreateWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockStoreMockRecorder) CreateWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockStore)(nil).CreateWebhookDelivery), arg0, arg1)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -125,102 +485,1036 @@ func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
 }
 
-// DeleteSnippet mocks base method.
-func (m *MockStore) DeleteSnippet(arg0 context.Context, arg1 int32) error {
+// DeleteCollection mocks base method.
+func (m *MockStore) DeleteCollection(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnippet", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteCollection", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSnippet indicates an expected call of DeleteSnippet.
-func (mr *MockStoreMockRecorder) DeleteSnippet(arg0, arg1 interface{}) *gomock.Call {
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockStoreMockRecorder) DeleteCollection(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnippet", reflect.TypeOf((*MockStore)(nil).DeleteSnippet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockStore)(nil).DeleteCollection), arg0, arg1)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(arg0 context.Context, arg1 int32) (db.Account, error) {
+// DeleteOrganizationMember mocks base method.
+func (m *MockStore) DeleteOrganizationMember(arg0 context.Context, arg1 db.DeleteOrganizationMemberParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+// DeleteOrganizationMember indicates an expected call of DeleteOrganizationMember.
+func (mr *MockStoreMockRecorder) DeleteOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMember", reflect.TypeOf((*MockStore)(nil).DeleteOrganizationMember), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// DeleteOtherSnippetFiles mocks base method.
+func (m *MockStore) DeleteOtherSnippetFiles(arg0 context.Context, arg1 db.DeleteOtherSnippetFilesParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteOtherSnippetFiles", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// DeleteOtherSnippetFiles indicates an expected call of DeleteOtherSnippetFiles.
+func (mr *MockStoreMockRecorder) DeleteOtherSnippetFiles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSnippetFiles", reflect.TypeOf((*MockStore)(nil).DeleteOtherSnippetFiles), arg0, arg1)
 }
 
-// GetSnippet mocks base method.
-func (m *MockStore) GetSnippet(arg0 context.Context, arg1 int32) (db.Snippet, error) {
+// DeletePublishedOutboxTasks mocks base method.
+func (m *MockStore) DeletePublishedOutboxTasks(arg0 context.Context, arg1 time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSnippet", arg0, arg1)
-	ret0, _ := ret[0].(db.Snippet)
+	ret := m.ctrl.Call(m, "DeletePublishedOutboxTasks", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSnippet indicates an expected call of GetSnippet.
-func (mr *MockStoreMockRecorder) GetSnippet(arg0, arg1 interface{}) *gomock.Call {
+// DeletePublishedOutboxTasks indicates an expected call of DeletePublishedOutboxTasks.
+func (mr *MockStoreMockRecorder) DeletePublishedOutboxTasks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippet", reflect.TypeOf((*MockStore)(nil).GetSnippet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublishedOutboxTasks", reflect.TypeOf((*MockStore)(nil).DeletePublishedOutboxTasks), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+// DeleteSnippet mocks base method.
+func (m *MockStore) DeleteSnippet(arg0 context.Context, arg1 int32) (db.Snippet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "DeleteSnippet", arg0, arg1)
+	ret0, _ := ret[0].(db.Snippet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSnippet indicates an expected call of DeleteSnippet.
+func (mr *MockStoreMockRecorder) DeleteSnippet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnippet", reflect.TypeOf((*MockStore)(nil).DeleteSnippet), arg0, arg1)
 }
 
-// ListSnippets mocks base method.
-func (m *MockStore) ListSnippets(arg0 context.Context, arg1 db.ListSnippetsParams) ([]db.Snippet, error) {
+// DeleteSnippetComment mocks base method.
+func (m *MockStore) DeleteSnippetComment(arg0 context.Context, arg1 db.DeleteSnippetCommentParams) (db.SnippetComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSnippets", arg0, arg1)
-	ret0, _ := ret[0].([]db.Snippet)
+	ret := m.ctrl.Call(m, "DeleteSnippetComment", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSnippets indicates an expected call of ListSnippets.
-func (mr *MockStoreMockRecorder) ListSnippets(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSnippetComment indicates an expected call of DeleteSnippetComment.
+func (mr *MockStoreMockRecorder) DeleteSnippetComment(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippets", reflect.TypeOf((*MockStore)(nil).ListSnippets), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnippetComment", reflect.TypeOf((*MockStore)(nil).DeleteSnippetComment), arg0, arg1)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockStore) UpdateAccount(arg0 context.Context, arg1 db.UpdateAccountParams) (db.Account, error) {
+// DeleteWebhook mocks base method.
+func (m *MockStore) DeleteWebhook(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockStoreMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockStore)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// ExpireExport mocks base method.
+func (m *MockStore) ExpireExport(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireExport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireExport indicates an expected call of ExpireExport.
+func (mr *MockStoreMockRecorder) ExpireExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireExport", reflect.TypeOf((*MockStore)(nil).ExpireExport), arg0, arg1)
+}
+
+// ExportAuditEvents mocks base method.
+func (m *MockStore) ExportAuditEvents(arg0 context.Context, arg1 db.ExportAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAuditEvents indicates an expected call of ExportAuditEvents.
+func (mr *MockStoreMockRecorder) ExportAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditEvents", reflect.TypeOf((*MockStore)(nil).ExportAuditEvents), arg0, arg1)
+}
+
+// FinishExport mocks base method.
+func (m *MockStore) FinishExport(arg0 context.Context, arg1 db.FinishExportParams) (db.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishExport", arg0, arg1)
+	ret0, _ := ret[0].(db.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishExport indicates an expected call of FinishExport.
+func (mr *MockStoreMockRecorder) FinishExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishExport", reflect.TypeOf((*MockStore)(nil).FinishExport), arg0, arg1)
+}
+
+// FinishSnippetImport mocks base method.
+func (m *MockStore) FinishSnippetImport(arg0 context.Context, arg1 db.FinishSnippetImportParams) (db.SnippetImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishSnippetImport", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishSnippetImport indicates an expected call of FinishSnippetImport.
+func (mr *MockStoreMockRecorder) FinishSnippetImport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSnippetImport", reflect.TypeOf((*MockStore)(nil).FinishSnippetImport), arg0, arg1)
+}
+
+// ForkSnippetTx mocks base method.
+func (m *MockStore) ForkSnippetTx(arg0 context.Context, arg1 db.ForkSnippetTxParams) (db.ForkSnippetTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkSnippetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ForkSnippetTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkSnippetTx indicates an expected call of ForkSnippetTx.
+func (mr *MockStoreMockRecorder) ForkSnippetTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkSnippetTx", reflect.TypeOf((*MockStore)(nil).ForkSnippetTx), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(arg0 context.Context, arg1 int32) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+}
+
+// GetCollection mocks base method.
+func (m *MockStore) GetCollection(arg0 context.Context, arg1 int64) (db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", arg0, arg1)
+	ret0, _ := ret[0].(db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockStoreMockRecorder) GetCollection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockStore)(nil).GetCollection), arg0, arg1)
+}
+
+// GetCollectionDepth mocks base method.
+func (m *MockStore) GetCollectionDepth(arg0 context.Context, arg1 db.GetCollectionDepthParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionDepth", arg0, arg1)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionDepth indicates an expected call of GetCollectionDepth.
+func (mr *MockStoreMockRecorder) GetCollectionDepth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionDepth", reflect.TypeOf((*MockStore)(nil).GetCollectionDepth), arg0, arg1)
+}
+
+// GetExport mocks base method.
+func (m *MockStore) GetExport(arg0 context.Context, arg1 int64) (db.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExport", arg0, arg1)
+	ret0, _ := ret[0].(db.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExport indicates an expected call of GetExport.
+func (mr *MockStoreMockRecorder) GetExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExport", reflect.TypeOf((*MockStore)(nil).GetExport), arg0, arg1)
+}
+
+// GetOrganization mocks base method.
+func (m *MockStore) GetOrganization(arg0 context.Context, arg1 int64) (db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", arg0, arg1)
+	ret0, _ := ret[0].(db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockStoreMockRecorder) GetOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockStore)(nil).GetOrganization), arg0, arg1)
+}
+
+// GetOrganizationInvitation mocks base method.
+func (m *MockStore) GetOrganizationInvitation(arg0 context.Context, arg1 int64) (db.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationInvitation indicates an expected call of GetOrganizationInvitation.
+func (mr *MockStoreMockRecorder) GetOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).GetOrganizationInvitation), arg0, arg1)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockStore) GetOrganizationMember(arg0 context.Context, arg1 db.GetOrganizationMemberParams) (db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockStoreMockRecorder) GetOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockStore)(nil).GetOrganizationMember), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSnippet mocks base method.
+func (m *MockStore) GetSnippet(arg0 context.Context, arg1 int32) (db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippet", arg0, arg1)
+	ret0, _ := ret[0].(db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippet indicates an expected call of GetSnippet.
+func (mr *MockStoreMockRecorder) GetSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippet", reflect.TypeOf((*MockStore)(nil).GetSnippet), arg0, arg1)
+}
+
+// GetSnippetByTitle mocks base method.
+func (m *MockStore) GetSnippetByTitle(arg0 context.Context, arg1 db.GetSnippetByTitleParams) (db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippetByTitle", arg0, arg1)
+	ret0, _ := ret[0].(db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippetByTitle indicates an expected call of GetSnippetByTitle.
+func (mr *MockStoreMockRecorder) GetSnippetByTitle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippetByTitle", reflect.TypeOf((*MockStore)(nil).GetSnippetByTitle), arg0, arg1)
+}
+
+// GetSnippetComment mocks base method.
+func (m *MockStore) GetSnippetComment(arg0 context.Context, arg1 int64) (db.SnippetComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippetComment", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippetComment indicates an expected call of GetSnippetComment.
+func (mr *MockStoreMockRecorder) GetSnippetComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippetComment", reflect.TypeOf((*MockStore)(nil).GetSnippetComment), arg0, arg1)
+}
+
+// GetSnippetFile mocks base method.
+func (m *MockStore) GetSnippetFile(arg0 context.Context, arg1 db.GetSnippetFileParams) (db.SnippetFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippetFile", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippetFile indicates an expected call of GetSnippetFile.
+func (mr *MockStoreMockRecorder) GetSnippetFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippetFile", reflect.TypeOf((*MockStore)(nil).GetSnippetFile), arg0, arg1)
+}
+
+// GetSnippetImport mocks base method.
+func (m *MockStore) GetSnippetImport(arg0 context.Context, arg1 int64) (db.SnippetImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippetImport", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippetImport indicates an expected call of GetSnippetImport.
+func (mr *MockStoreMockRecorder) GetSnippetImport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippetImport", reflect.TypeOf((*MockStore)(nil).GetSnippetImport), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetWebhook mocks base method.
+func (m *MockStore) GetWebhook(arg0 context.Context, arg1 int64) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", arg0, arg1)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockStoreMockRecorder) GetWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockStore)(nil).GetWebhook), arg0, arg1)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockStore) GetWebhookDelivery(arg0 context.Context, arg1 int64) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockStoreMockRecorder) GetWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockStore)(nil).GetWebhookDelivery), arg0, arg1)
+}
+
+// IsSnippetStarred mocks base method.
+func (m *MockStore) IsSnippetStarred(arg0 context.Context, arg1 db.IsSnippetStarredParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSnippetStarred", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSnippetStarred indicates an expected call of IsSnippetStarred.
+func (mr *MockStoreMockRecorder) IsSnippetStarred(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSnippetStarred", reflect.TypeOf((*MockStore)(nil).IsSnippetStarred), arg0, arg1)
+}
+
+// ListAccountsByLogin mocks base method.
+func (m *MockStore) ListAccountsByLogin(arg0 context.Context, arg1 string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByLogin", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByLogin indicates an expected call of ListAccountsByLogin.
+func (mr *MockStoreMockRecorder) ListAccountsByLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByLogin", reflect.TypeOf((*MockStore)(nil).ListAccountsByLogin), arg0, arg1)
+}
+
+// ListAccountsByOrganization mocks base method.
+func (m *MockStore) ListAccountsByOrganization(arg0 context.Context, arg1 sql.NullInt64) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByOrganization", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByOrganization indicates an expected call of ListAccountsByOrganization.
+func (mr *MockStoreMockRecorder) ListAccountsByOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByOrganization", reflect.TypeOf((*MockStore)(nil).ListAccountsByOrganization), arg0, arg1)
+}
+
+// ListActiveWebhooksForEvent mocks base method.
+func (m *MockStore) ListActiveWebhooksForEvent(arg0 context.Context, arg1 db.ListActiveWebhooksForEventParams) ([]db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveWebhooksForEvent", arg0, arg1)
+	ret0, _ := ret[0].([]db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveWebhooksForEvent indicates an expected call of ListActiveWebhooksForEvent.
+func (mr *MockStoreMockRecorder) ListActiveWebhooksForEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveWebhooksForEvent", reflect.TypeOf((*MockStore)(nil).ListActiveWebhooksForEvent), arg0, arg1)
+}
+
+// ListCollectionSnippetIDs mocks base method.
+func (m *MockStore) ListCollectionSnippetIDs(arg0 context.Context, arg1 int64) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollectionSnippetIDs", arg0, arg1)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollectionSnippetIDs indicates an expected call of ListCollectionSnippetIDs.
+func (mr *MockStoreMockRecorder) ListCollectionSnippetIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollectionSnippetIDs", reflect.TypeOf((*MockStore)(nil).ListCollectionSnippetIDs), arg0, arg1)
+}
+
+// ListCollectionSubtree mocks base method.
+func (m *MockStore) ListCollectionSubtree(arg0 context.Context, arg1 db.ListCollectionSubtreeParams) ([]db.ListCollectionSubtreeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollectionSubtree", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCollectionSubtreeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollectionSubtree indicates an expected call of ListCollectionSubtree.
+func (mr *MockStoreMockRecorder) ListCollectionSubtree(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollectionSubtree", reflect.TypeOf((*MockStore)(nil).ListCollectionSubtree), arg0, arg1)
+}
+
+// ListCollections mocks base method.
+func (m *MockStore) ListCollections(arg0 context.Context, arg1 int32) ([]db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollections", arg0, arg1)
+	ret0, _ := ret[0].([]db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollections indicates an expected call of ListCollections.
+func (mr *MockStoreMockRecorder) ListCollections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollections", reflect.TypeOf((*MockStore)(nil).ListCollections), arg0, arg1)
+}
+
+// ListExpiredExports mocks base method.
+func (m *MockStore) ListExpiredExports(arg0 context.Context, arg1 db.ListExpiredExportsParams) ([]db.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredExports", arg0, arg1)
+	ret0, _ := ret[0].([]db.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredExports indicates an expected call of ListExpiredExports.
+func (mr *MockStoreMockRecorder) ListExpiredExports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredExports", reflect.TypeOf((*MockStore)(nil).ListExpiredExports), arg0, arg1)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockStore) ListOrganizationMembers(arg0 context.Context, arg1 int64) ([]db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockStoreMockRecorder) ListOrganizationMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockStore)(nil).ListOrganizationMembers), arg0, arg1)
+}
+
+// ListOrganizationsByMember mocks base method.
+func (m *MockStore) ListOrganizationsByMember(arg0 context.Context, arg1 string) ([]db.ListOrganizationsByMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationsByMember", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListOrganizationsByMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationsByMember indicates an expected call of ListOrganizationsByMember.
+func (mr *MockStoreMockRecorder) ListOrganizationsByMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationsByMember", reflect.TypeOf((*MockStore)(nil).ListOrganizationsByMember), arg0, arg1)
+}
+
+// ListPendingInvitationsByInvitee mocks base method.
+func (m *MockStore) ListPendingInvitationsByInvitee(arg0 context.Context, arg1 string) ([]db.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingInvitationsByInvitee", arg0, arg1)
+	ret0, _ := ret[0].([]db.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingInvitationsByInvitee indicates an expected call of ListPendingInvitationsByInvitee.
+func (mr *MockStoreMockRecorder) ListPendingInvitationsByInvitee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingInvitationsByInvitee", reflect.TypeOf((*MockStore)(nil).ListPendingInvitationsByInvitee), arg0, arg1)
+}
+
+// ListPendingOutboxTasks mocks base method.
+func (m *MockStore) ListPendingOutboxTasks(arg0 context.Context, arg1 int32) ([]db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboxTasks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboxTasks indicates an expected call of ListPendingOutboxTasks.
+func (mr *MockStoreMockRecorder) ListPendingOutboxTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboxTasks", reflect.TypeOf((*MockStore)(nil).ListPendingOutboxTasks), arg0, arg1)
+}
+
+// ListSessionsByName mocks base method.
+func (m *MockStore) ListSessionsByName(arg0 context.Context, arg1 string) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionsByName", arg0, arg1)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionsByName indicates an expected call of ListSessionsByName.
+func (mr *MockStoreMockRecorder) ListSessionsByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionsByName", reflect.TypeOf((*MockStore)(nil).ListSessionsByName), arg0, arg1)
+}
+
+// ListSnippetComments mocks base method.
+func (m *MockStore) ListSnippetComments(arg0 context.Context, arg1 db.ListSnippetCommentsParams) ([]db.SnippetComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippetComments", arg0, arg1)
+	ret0, _ := ret[0].([]db.SnippetComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippetComments indicates an expected call of ListSnippetComments.
+func (mr *MockStoreMockRecorder) ListSnippetComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippetComments", reflect.TypeOf((*MockStore)(nil).ListSnippetComments), arg0, arg1)
+}
+
+// ListSnippetFiles mocks base method.
+func (m *MockStore) ListSnippetFiles(arg0 context.Context, arg1 int32) ([]db.SnippetFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippetFiles", arg0, arg1)
+	ret0, _ := ret[0].([]db.SnippetFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippetFiles indicates an expected call of ListSnippetFiles.
+func (mr *MockStoreMockRecorder) ListSnippetFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippetFiles", reflect.TypeOf((*MockStore)(nil).ListSnippetFiles), arg0, arg1)
+}
+
+// ListSnippetForks mocks base method.
+func (m *MockStore) ListSnippetForks(arg0 context.Context, arg1 db.ListSnippetForksParams) ([]db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippetForks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippetForks indicates an expected call of ListSnippetForks.
+func (mr *MockStoreMockRecorder) ListSnippetForks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippetForks", reflect.TypeOf((*MockStore)(nil).ListSnippetForks), arg0, arg1)
+}
+
+// ListSnippets mocks base method.
+func (m *MockStore) ListSnippets(arg0 context.Context, arg1 db.ListSnippetsParams) ([]db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippets", arg0, arg1)
+	ret0, _ := ret[0].([]db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippets indicates an expected call of ListSnippets.
+func (mr *MockStoreMockRecorder) ListSnippets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippets", reflect.TypeOf((*MockStore)(nil).ListSnippets), arg0, arg1)
+}
+
+// ListSnippetsByCollection mocks base method.
+func (m *MockStore) ListSnippetsByCollection(arg0 context.Context, arg1 db.ListSnippetsByCollectionParams) ([]db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippetsByCollection", arg0, arg1)
+	ret0, _ := ret[0].([]db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippetsByCollection indicates an expected call of ListSnippetsByCollection.
+func (mr *MockStoreMockRecorder) ListSnippetsByCollection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippetsByCollection", reflect.TypeOf((*MockStore)(nil).ListSnippetsByCollection), arg0, arg1)
+}
+
+// ListStarredSnippets mocks base method.
+func (m *MockStore) ListStarredSnippets(arg0 context.Context, arg1 db.ListStarredSnippetsParams) ([]db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStarredSnippets", arg0, arg1)
+	ret0, _ := ret[0].([]db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStarredSnippets indicates an expected call of ListStarredSnippets.
+func (mr *MockStoreMockRecorder) ListStarredSnippets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStarredSnippets", reflect.TypeOf((*MockStore)(nil).ListStarredSnippets), arg0, arg1)
+}
+
+// ListUserAuditEvents mocks base method.
+func (m *MockStore) ListUserAuditEvents(arg0 context.Context, arg1 db.ListUserAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserAuditEvents indicates an expected call of ListUserAuditEvents.
+func (mr *MockStoreMockRecorder) ListUserAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserAuditEvents", reflect.TypeOf((*MockStore)(nil).ListUserAuditEvents), arg0, arg1)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockStore) ListWebhookDeliveries(arg0 context.Context, arg1 db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ListWebhookDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ListWebhookDeliveries), arg0, arg1)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockStore) ListWebhooks(arg0 context.Context, arg1 string) ([]db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockStoreMockRecorder) ListWebhooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockStore)(nil).ListWebhooks), arg0, arg1)
+}
+
+// LockAccountCollections mocks base method.
+func (m *MockStore) LockAccountCollections(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccountCollections", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccountCollections indicates an expected call of LockAccountCollections.
+func (mr *MockStoreMockRecorder) LockAccountCollections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccountCollections", reflect.TypeOf((*MockStore)(nil).LockAccountCollections), arg0, arg1)
+}
+
+// LockOrganizationOwners mocks base method.
+func (m *MockStore) LockOrganizationOwners(arg0 context.Context, arg1 int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOrganizationOwners", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOrganizationOwners indicates an expected call of LockOrganizationOwners.
+func (mr *MockStoreMockRecorder) LockOrganizationOwners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOrganizationOwners", reflect.TypeOf((*MockStore)(nil).LockOrganizationOwners), arg0, arg1)
+}
+
+// MarkOutboxTaskFailed mocks base method.
+func (m *MockStore) MarkOutboxTaskFailed(arg0 context.Context, arg1 db.MarkOutboxTaskFailedParams) (db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxTaskFailed", arg0, arg1)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOutboxTaskFailed indicates an expected call of MarkOutboxTaskFailed.
+func (mr *MockStoreMockRecorder) MarkOutboxTaskFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxTaskFailed", reflect.TypeOf((*MockStore)(nil).MarkOutboxTaskFailed), arg0, arg1)
+}
+
+// MarkOutboxTaskPublished mocks base method.
+func (m *MockStore) MarkOutboxTaskPublished(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxTaskPublished", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxTaskPublished indicates an expected call of MarkOutboxTaskPublished.
+func (mr *MockStoreMockRecorder) MarkOutboxTaskPublished(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxTaskPublished", reflect.TypeOf((*MockStore)(nil).MarkOutboxTaskPublished), arg0, arg1)
+}
+
+// MoveCollection mocks base method.
+func (m *MockStore) MoveCollection(arg0 context.Context, arg1 db.MoveCollectionParams) (db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCollection", arg0, arg1)
+	ret0, _ := ret[0].(db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCollection indicates an expected call of MoveCollection.
+func (mr *MockStoreMockRecorder) MoveCollection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCollection", reflect.TypeOf((*MockStore)(nil).MoveCollection), arg0, arg1)
+}
+
+// MoveCollectionTx mocks base method.
+func (m *MockStore) MoveCollectionTx(arg0 context.Context, arg1 db.MoveCollectionTxParams) (db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCollectionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCollectionTx indicates an expected call of MoveCollectionTx.
+func (mr *MockStoreMockRecorder) MoveCollectionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCollectionTx", reflect.TypeOf((*MockStore)(nil).MoveCollectionTx), arg0, arg1)
+}
+
+// PublishOutboxTx mocks base method.
+func (m *MockStore) PublishOutboxTx(arg0 context.Context, arg1 db.PublishOutboxTxParams) (db.PublishOutboxTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishOutboxTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PublishOutboxTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishOutboxTx indicates an expected call of PublishOutboxTx.
+func (mr *MockStoreMockRecorder) PublishOutboxTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishOutboxTx", reflect.TypeOf((*MockStore)(nil).PublishOutboxTx), arg0, arg1)
+}
+
+// RecordWebhookFailure mocks base method.
+func (m *MockStore) RecordWebhookFailure(arg0 context.Context, arg1 db.RecordWebhookFailureParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookFailure", arg0, arg1)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWebhookFailure indicates an expected call of RecordWebhookFailure.
+func (mr *MockStoreMockRecorder) RecordWebhookFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookFailure", reflect.TypeOf((*MockStore)(nil).RecordWebhookFailure), arg0, arg1)
+}
+
+// RemoveCollectionSnippet mocks base method.
+func (m *MockStore) RemoveCollectionSnippet(arg0 context.Context, arg1 db.RemoveCollectionSnippetParams) (db.CollectionSnippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollectionSnippet", arg0, arg1)
+	ret0, _ := ret[0].(db.CollectionSnippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCollectionSnippet indicates an expected call of RemoveCollectionSnippet.
+func (mr *MockStoreMockRecorder) RemoveCollectionSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollectionSnippet", reflect.TypeOf((*MockStore)(nil).RemoveCollectionSnippet), arg0, arg1)
+}
+
+// RemoveOrganizationMemberTx mocks base method.
+func (m *MockStore) RemoveOrganizationMemberTx(arg0 context.Context, arg1 db.DeleteOrganizationMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMemberTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrganizationMemberTx indicates an expected call of RemoveOrganizationMemberTx.
+func (mr *MockStoreMockRecorder) RemoveOrganizationMemberTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMemberTx", reflect.TypeOf((*MockStore)(nil).RemoveOrganizationMemberTx), arg0, arg1)
+}
+
+// RenameCollection mocks base method.
+func (m *MockStore) RenameCollection(arg0 context.Context, arg1 db.RenameCollectionParams) (db.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameCollection", arg0, arg1)
+	ret0, _ := ret[0].(db.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameCollection indicates an expected call of RenameCollection.
+func (mr *MockStoreMockRecorder) RenameCollection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCollection", reflect.TypeOf((*MockStore)(nil).RenameCollection), arg0, arg1)
+}
+
+// ReorderCollectionTx mocks base method.
+func (m *MockStore) ReorderCollectionTx(arg0 context.Context, arg1 db.ReorderCollectionTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCollectionTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCollectionTx indicates an expected call of ReorderCollectionTx.
+func (mr *MockStoreMockRecorder) ReorderCollectionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCollectionTx", reflect.TypeOf((*MockStore)(nil).ReorderCollectionTx), arg0, arg1)
+}
+
+// ResetWebhookFailures mocks base method.
+func (m *MockStore) ResetWebhookFailures(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWebhookFailures", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWebhookFailures indicates an expected call of ResetWebhookFailures.
+func (mr *MockStoreMockRecorder) ResetWebhookFailures(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookFailures", reflect.TypeOf((*MockStore)(nil).ResetWebhookFailures), arg0, arg1)
+}
+
+// RespondOrganizationInvitation mocks base method.
+func (m *MockStore) RespondOrganizationInvitation(arg0 context.Context, arg1 db.RespondOrganizationInvitationParams) (db.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondOrganizationInvitation indicates an expected call of RespondOrganizationInvitation.
+func (mr *MockStoreMockRecorder) RespondOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).RespondOrganizationInvitation), arg0, arg1)
+}
+
+// SearchAuditEvents mocks base method.
+func (m *MockStore) SearchAuditEvents(arg0 context.Context, arg1 db.SearchAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAuditEvents indicates an expected call of SearchAuditEvents.
+func (mr *MockStoreMockRecorder) SearchAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditEvents", reflect.TypeOf((*MockStore)(nil).SearchAuditEvents), arg0, arg1)
+}
+
+// StarSnippet mocks base method.
+func (m *MockStore) StarSnippet(arg0 context.Context, arg1 db.StarSnippetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StarSnippet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StarSnippet indicates an expected call of StarSnippet.
+func (mr *MockStoreMockRecorder) StarSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StarSnippet", reflect.TypeOf((*MockStore)(nil).StarSnippet), arg0, arg1)
+}
+
+// StartSnippetImport mocks base method.
+func (m *MockStore) StartSnippetImport(arg0 context.Context, arg1 int64) (db.SnippetImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSnippetImport", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSnippetImport indicates an expected call of StartSnippetImport.
+func (mr *MockStoreMockRecorder) StartSnippetImport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSnippetImport", reflect.TypeOf((*MockStore)(nil).StartSnippetImport), arg0, arg1)
+}
+
+// TransferAccount mocks base method.
+func (m *MockStore) TransferAccount(arg0 context.Context, arg1 db.TransferAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccount indicates an expected call of TransferAccount.
+func (mr *MockStoreMockRecorder) TransferAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccount", reflect.TypeOf((*MockStore)(nil).TransferAccount), arg0, arg1)
+}
+
+// UnstarSnippet mocks base method.
+func (m *MockStore) UnstarSnippet(arg0 context.Context, arg1 db.UnstarSnippetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnstarSnippet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnstarSnippet indicates an expected call of UnstarSnippet.
+func (mr *MockStoreMockRecorder) UnstarSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnstarSnippet", reflect.TypeOf((*MockStore)(nil).UnstarSnippet), arg0, arg1)
+}
+
+// UpdateAccount mocks base method.
+func (m *MockStore) UpdateAccount(arg0 context.Context, arg1 db.UpdateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateAccount indicates an expected call of UpdateAccount.
@@ -229,6 +1523,95 @@ func (mr *MockStoreMockRecorder) UpdateAccount(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), arg0, arg1)
 }
 
+// UpdateCollectionSnippetPosition mocks base method.
+func (m *MockStore) UpdateCollectionSnippetPosition(arg0 context.Context, arg1 db.UpdateCollectionSnippetPositionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollectionSnippetPosition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollectionSnippetPosition indicates an expected call of UpdateCollectionSnippetPosition.
+func (mr *MockStoreMockRecorder) UpdateCollectionSnippetPosition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollectionSnippetPosition", reflect.TypeOf((*MockStore)(nil).UpdateCollectionSnippetPosition), arg0, arg1)
+}
+
+// UpdateOrganizationMemberRole mocks base method.
+func (m *MockStore) UpdateOrganizationMemberRole(arg0 context.Context, arg1 db.UpdateOrganizationMemberRoleParams) (db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganizationMemberRole indicates an expected call of UpdateOrganizationMemberRole.
+func (mr *MockStoreMockRecorder) UpdateOrganizationMemberRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationMemberRole", reflect.TypeOf((*MockStore)(nil).UpdateOrganizationMemberRole), arg0, arg1)
+}
+
+// UpdateOrganizationMemberRoleTx mocks base method.
+func (m *MockStore) UpdateOrganizationMemberRoleTx(arg0 context.Context, arg1 db.UpdateOrganizationMemberRoleParams) (db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationMemberRoleTx", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganizationMemberRoleTx indicates an expected call of UpdateOrganizationMemberRoleTx.
+func (mr *MockStoreMockRecorder) UpdateOrganizationMemberRoleTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationMemberRoleTx", reflect.TypeOf((*MockStore)(nil).UpdateOrganizationMemberRoleTx), arg0, arg1)
+}
+
+// UpdateSnippet mocks base method.
+func (m *MockStore) UpdateSnippet(arg0 context.Context, arg1 db.UpdateSnippetParams) (db.Snippet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnippet", arg0, arg1)
+	ret0, _ := ret[0].(db.Snippet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnippet indicates an expected call of UpdateSnippet.
+func (mr *MockStoreMockRecorder) UpdateSnippet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnippet", reflect.TypeOf((*MockStore)(nil).UpdateSnippet), arg0, arg1)
+}
+
+// UpdateSnippetComment mocks base method.
+func (m *MockStore) UpdateSnippetComment(arg0 context.Context, arg1 db.UpdateSnippetCommentParams) (db.SnippetComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnippetComment", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnippetComment indicates an expected call of UpdateSnippetComment.
+func (mr *MockStoreMockRecorder) UpdateSnippetComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnippetComment", reflect.TypeOf((*MockStore)(nil).UpdateSnippetComment), arg0, arg1)
+}
+
+// UpdateSnippetTx mocks base method.
+func (m *MockStore) UpdateSnippetTx(arg0 context.Context, arg1 db.UpdateSnippetTxParams) (db.UpdateSnippetTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnippetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateSnippetTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnippetTx indicates an expected call of UpdateSnippetTx.
+func (mr *MockStoreMockRecorder) UpdateSnippetTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnippetTx", reflect.TypeOf((*MockStore)(nil).UpdateSnippetTx), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -243,3 +1626,33 @@ func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
 }
+
+// UpdateWebhook mocks base method.
+func (m *MockStore) UpdateWebhook(arg0 context.Context, arg1 db.UpdateWebhookParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockStoreMockRecorder) UpdateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockStore)(nil).UpdateWebhook), arg0, arg1)
+}
+
+// UpsertSnippetFile mocks base method.
+func (m *MockStore) UpsertSnippetFile(arg0 context.Context, arg1 db.UpsertSnippetFileParams) (db.SnippetFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSnippetFile", arg0, arg1)
+	ret0, _ := ret[0].(db.SnippetFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSnippetFile indicates an expected call of UpsertSnippetFile.
+func (mr *MockStoreMockRecorder) UpsertSnippetFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSnippetFile", reflect.TypeOf((*MockStore)(nil).UpsertSnippetFile), arg0, arg1)
+}
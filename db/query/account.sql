@@ -19,4 +19,22 @@ RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM account
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: ListAccountsByLogin :many
+SELECT * FROM account
+WHERE login = $1 AND organization_id IS NULL
+ORDER BY id;
+
+-- name: ListAccountsByOrganization :many
+SELECT * FROM account
+WHERE organization_id = $1
+ORDER BY id;
+
+-- name: TransferAccount :one
+UPDATE account
+SET
+  login = sqlc.arg(login),
+  organization_id = sqlc.narg(organization_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,43 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+  actor,
+  action,
+  target,
+  client_ip,
+  user_agent,
+  metadata
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: SearchAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+  (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor))
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(target)::varchar IS NULL OR target = sqlc.narg(target))
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created < sqlc.narg(until))
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
+
+-- name: ListUserAuditEvents :many
+SELECT * FROM audit_events
+WHERE actor = sqlc.arg(actor) OR target = sqlc.arg(target)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
+
+-- name: ExportAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+  id > sqlc.arg(after_id)
+  AND (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor))
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(target)::varchar IS NULL OR target = sqlc.narg(target))
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created < sqlc.narg(until))
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
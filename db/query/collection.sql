@@ -0,0 +1,96 @@
+-- name: CreateCollection :one
+INSERT INTO collections (
+  account_id,
+  parent_id,
+  name
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetCollection :one
+SELECT * FROM collections
+WHERE id = $1 LIMIT 1;
+
+-- name: ListCollections :many
+SELECT * FROM collections
+WHERE account_id = $1
+ORDER BY parent_id NULLS FIRST, name;
+
+-- name: RenameCollection :one
+UPDATE collections
+SET name = sqlc.arg(name)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: LockAccountCollections :exec
+SELECT id FROM collections
+WHERE account_id = $1
+ORDER BY id
+FOR UPDATE;
+
+-- name: MoveCollection :one
+UPDATE collections
+SET parent_id = sqlc.narg(parent_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteCollection :exec
+DELETE FROM collections
+WHERE id = $1;
+
+-- name: GetCollectionDepth :one
+WITH RECURSIVE ancestors AS (
+  SELECT c.id, c.parent_id, 1 AS depth FROM collections c
+  WHERE c.id = sqlc.arg(id)
+  UNION ALL
+  SELECT c.id, c.parent_id, a.depth + 1 FROM collections c
+  JOIN ancestors a ON c.id = a.parent_id
+  WHERE a.depth < sqlc.arg(max_depth)::integer
+)
+SELECT max(depth)::integer FROM ancestors;
+
+-- name: ListCollectionSubtree :many
+WITH RECURSIVE subtree AS (
+  SELECT c.id, 1 AS level FROM collections c
+  WHERE c.id = sqlc.arg(id)
+  UNION ALL
+  SELECT c.id, s.level + 1 FROM collections c
+  JOIN subtree s ON c.parent_id = s.id
+  WHERE s.level < sqlc.arg(max_level)::integer
+)
+SELECT id, level::integer FROM subtree;
+
+-- name: AddCollectionSnippet :one
+INSERT INTO collection_snippets (
+  collection_id,
+  snippet_id,
+  position
+)
+SELECT sqlc.arg(collection_id), sqlc.arg(snippet_id), COALESCE(max(position), 0) + 1
+FROM collection_snippets
+WHERE collection_id = sqlc.arg(collection_id)
+RETURNING *;
+
+-- name: RemoveCollectionSnippet :one
+DELETE FROM collection_snippets
+WHERE collection_id = $1 AND snippet_id = $2
+RETURNING *;
+
+-- name: ListCollectionSnippetIDs :many
+SELECT snippet_id FROM collection_snippets
+WHERE collection_id = $1
+ORDER BY position, snippet_id;
+
+-- name: UpdateCollectionSnippetPosition :exec
+UPDATE collection_snippets
+SET position = sqlc.arg(position)
+WHERE collection_id = sqlc.arg(collection_id) AND snippet_id = sqlc.arg(snippet_id);
+
+-- name: ListSnippetsByCollection :many
+SELECT s.* FROM snippets s
+JOIN collection_snippets cs ON cs.snippet_id = s.id
+WHERE cs.collection_id = $1
+ORDER BY cs.position, s.id
+LIMIT $2
+OFFSET $3;
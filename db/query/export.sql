@@ -0,0 +1,36 @@
+-- name: CreateExport :one
+INSERT INTO exports (
+  owner
+) VALUES (
+  $1
+)
+RETURNING *;
+
+-- name: GetExport :one
+SELECT * FROM exports
+WHERE id = $1 LIMIT 1;
+
+-- name: FinishExport :one
+UPDATE exports
+SET
+  status = sqlc.arg(status),
+  blob_key = sqlc.narg(blob_key),
+  size = sqlc.arg(size),
+  error = sqlc.narg(error),
+  expires_at = sqlc.narg(expires_at),
+  finished_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListExpiredExports :many
+SELECT * FROM exports
+WHERE status = 'completed' AND expires_at < sqlc.arg(before)::timestamptz
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ExpireExport :exec
+UPDATE exports
+SET
+  status = 'expired',
+  blob_key = NULL
+WHERE id = $1;
\ No newline at end of file
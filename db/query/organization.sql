@@ -0,0 +1,82 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (
+  name,
+  display_name
+) VALUES (
+  $1, $2
+)
+RETURNING *;
+
+-- name: GetOrganization :one
+SELECT * FROM organizations
+WHERE id = $1 LIMIT 1;
+
+-- name: ListOrganizationsByMember :many
+SELECT o.*, m.role FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.username = $1
+ORDER BY o.name;
+
+-- name: CreateOrganizationMember :one
+INSERT INTO organization_members (
+  organization_id,
+  username,
+  role
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetOrganizationMember :one
+SELECT * FROM organization_members
+WHERE organization_id = $1 AND username = $2 LIMIT 1;
+
+-- name: ListOrganizationMembers :many
+SELECT * FROM organization_members
+WHERE organization_id = $1
+ORDER BY username;
+
+-- name: UpdateOrganizationMemberRole :one
+UPDATE organization_members
+SET role = sqlc.arg(role)
+WHERE organization_id = sqlc.arg(organization_id) AND username = sqlc.arg(username)
+RETURNING *;
+
+-- name: DeleteOrganizationMember :exec
+DELETE FROM organization_members
+WHERE organization_id = $1 AND username = $2;
+
+-- name: LockOrganizationOwners :many
+SELECT username FROM organization_members
+WHERE organization_id = $1 AND role = 'owner'
+ORDER BY username
+FOR UPDATE;
+
+-- name: CreateOrganizationInvitation :one
+INSERT INTO organization_invitations (
+  organization_id,
+  invitee,
+  role,
+  invited_by,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetOrganizationInvitation :one
+SELECT * FROM organization_invitations
+WHERE id = $1 LIMIT 1;
+
+-- name: ListPendingInvitationsByInvitee :many
+SELECT * FROM organization_invitations
+WHERE invitee = $1 AND status = 'pending' AND expires_at > now()
+ORDER BY id;
+
+-- name: RespondOrganizationInvitation :one
+UPDATE organization_invitations
+SET
+  status = sqlc.arg(status),
+  responded_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,42 @@
+-- name: CreateOutboxTask :one
+INSERT INTO outbox (
+  task_type,
+  payload,
+  queue,
+  max_retry,
+  process_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListPendingOutboxTasks :many
+SELECT * FROM outbox
+WHERE published_at IS NULL
+  AND dead_at IS NULL
+  AND next_attempt_at <= now()
+ORDER BY next_attempt_at, id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxTaskPublished :exec
+UPDATE outbox
+SET
+  published_at = now(),
+  attempts = attempts + 1,
+  last_error = NULL
+WHERE id = $1;
+
+-- name: MarkOutboxTaskFailed :one
+UPDATE outbox
+SET
+  attempts = attempts + 1,
+  last_error = $2,
+  next_attempt_at = sqlc.arg(next_attempt_at),
+  dead_at = CASE WHEN attempts + 1 >= max_attempts THEN now() END
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePublishedOutboxTasks :execrows
+DELETE FROM outbox
+WHERE published_at < sqlc.arg(before)::timestamptz;
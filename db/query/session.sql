@@ -16,4 +16,14 @@ RETURNING *;
 
 -- name: GetSession :one
 SELECT * FROM sessions 
-WHERE id = $1 LIMIT 1;
\ No newline at end of file
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSessionsByName :many
+SELECT * FROM sessions
+WHERE name = $1
+ORDER BY created;
+-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = true
+WHERE id = $1
+RETURNING *;
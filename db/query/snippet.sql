@@ -2,9 +2,10 @@
 INSERT INTO snippets (
   account_id,
   title,
-  content
+  language,
+  is_public
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 )
 RETURNING *;
 
@@ -12,6 +13,12 @@ RETURNING *;
 SELECT * FROM snippets
 WHERE id = $1 LIMIT 1;
 
+-- name: GetSnippetByTitle :one
+SELECT * FROM snippets
+WHERE account_id = $1 AND title = $2
+ORDER BY id
+LIMIT 1;
+
 -- name: ListSnippets :many
 SELECT * FROM snippets
 WHERE account_id = $1
@@ -19,6 +26,46 @@ ORDER BY id
 LIMIT $2
 OFFSET $3;
 
--- name: DeleteSnippet :exec
+-- name: UpdateSnippet :one
+UPDATE snippets
+SET
+  title = COALESCE(sqlc.narg(title), title),
+  language = COALESCE(sqlc.narg(language), language),
+  is_public = COALESCE(sqlc.narg(is_public), is_public)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteSnippet :one
 DELETE FROM snippets
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateSnippetFork :one
+INSERT INTO snippets (
+  account_id,
+  title,
+  language,
+  forked_from
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: CountSnippetForks :one
+SELECT count(*) FROM snippets
+WHERE forked_from = $1;
+
+-- name: ListSnippetForks :many
+SELECT s.* FROM snippets s
+JOIN account a ON a.id = s.account_id
+WHERE s.forked_from = sqlc.arg(forked_from) AND (
+  s.is_public
+  OR (a.organization_id IS NULL AND a.login = sqlc.arg(username))
+  OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.organization_id = a.organization_id AND m.username = sqlc.arg(username)
+  )
+)
+ORDER BY s.id
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
\ No newline at end of file
@@ -0,0 +1,44 @@
+-- name: CreateSnippetComment :one
+INSERT INTO snippet_comments (
+  snippet_id,
+  parent_id,
+  author,
+  body,
+  line_start,
+  line_end,
+  filename
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetSnippetComment :one
+SELECT * FROM snippet_comments
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSnippetComments :many
+SELECT * FROM snippet_comments
+WHERE snippet_id = $1
+ORDER BY COALESCE(parent_id, id), id
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdateSnippetComment :one
+UPDATE snippet_comments
+SET body = $2, edited_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteSnippetComment :one
+UPDATE snippet_comments
+SET deleted_at = now(), deleted_by = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ClearStaleSnippetCommentAnchors :exec
+UPDATE snippet_comments c
+SET filename = NULL, line_start = NULL, line_end = NULL
+WHERE c.snippet_id = $1 AND c.filename IS NOT NULL AND NOT EXISTS (
+  SELECT 1 FROM snippet_files f
+  WHERE f.snippet_id = c.snippet_id AND f.filename = c.filename
+    AND c.line_end <= length(regexp_replace(f.content, E'\n$', '')) - length(replace(regexp_replace(f.content, E'\n$', ''), E'\n', '')) + 1
+);
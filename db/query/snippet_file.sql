@@ -0,0 +1,40 @@
+-- name: UpsertSnippetFile :one
+INSERT INTO snippet_files (
+  snippet_id,
+  filename,
+  language,
+  content,
+  position
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (snippet_id, filename) DO UPDATE
+SET language = EXCLUDED.language, content = EXCLUDED.content, position = EXCLUDED.position
+RETURNING *;
+
+-- name: GetSnippetFile :one
+SELECT * FROM snippet_files
+WHERE snippet_id = $1 AND filename = $2
+LIMIT 1;
+
+-- name: ListSnippetFiles :many
+SELECT * FROM snippet_files
+WHERE snippet_id = $1
+ORDER BY position;
+
+-- name: DeleteOtherSnippetFiles :exec
+DELETE FROM snippet_files
+WHERE snippet_id = sqlc.arg(snippet_id) AND NOT (filename = ANY(sqlc.arg(filenames)::varchar[]));
+
+-- name: CopySnippetFiles :exec
+INSERT INTO snippet_files (
+  snippet_id,
+  filename,
+  language,
+  content,
+  position
+)
+SELECT sqlc.arg(to_snippet_id)::integer, filename, language, content, position
+FROM snippet_files
+WHERE snippet_id = sqlc.arg(from_snippet_id)
+ORDER BY position;
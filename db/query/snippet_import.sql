@@ -0,0 +1,43 @@
+-- name: CreateSnippetImport :one
+INSERT INTO snippet_imports (
+  owner,
+  account_id,
+  format,
+  duplicate_policy,
+  archive
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetSnippetImport :one
+SELECT * FROM snippet_imports
+WHERE id = $1 LIMIT 1;
+
+-- name: StartSnippetImport :one
+UPDATE snippet_imports
+SET
+  status = 'running',
+  total = 0,
+  created_count = 0,
+  updated_count = 0,
+  skipped_count = 0,
+  failed_count = 0,
+  errors = '[]'
+WHERE id = $1
+RETURNING *;
+
+-- name: FinishSnippetImport :one
+UPDATE snippet_imports
+SET
+  status = sqlc.arg(status),
+  total = sqlc.arg(total),
+  created_count = sqlc.arg(created_count),
+  updated_count = sqlc.arg(updated_count),
+  skipped_count = sqlc.arg(skipped_count),
+  failed_count = sqlc.arg(failed_count),
+  errors = sqlc.arg(errors),
+  archive = NULL,
+  finished_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
\ No newline at end of file
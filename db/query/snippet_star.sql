@@ -0,0 +1,38 @@
+-- name: StarSnippet :exec
+INSERT INTO snippet_stars (
+  username,
+  snippet_id
+) VALUES (
+  $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: UnstarSnippet :exec
+DELETE FROM snippet_stars
+WHERE username = $1 AND snippet_id = $2;
+
+-- name: CountSnippetStars :one
+SELECT count(*) FROM snippet_stars
+WHERE snippet_id = $1;
+
+-- name: IsSnippetStarred :one
+SELECT EXISTS (
+  SELECT 1 FROM snippet_stars
+  WHERE username = $1 AND snippet_id = $2
+);
+
+-- name: ListStarredSnippets :many
+SELECT s.* FROM snippet_stars st
+JOIN snippets s ON s.id = st.snippet_id
+JOIN account a ON a.id = s.account_id
+WHERE st.username = sqlc.arg(username) AND (
+  s.is_public
+  OR (a.organization_id IS NULL AND a.login = sqlc.arg(username))
+  OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.organization_id = a.organization_id AND m.username = sqlc.arg(username)
+  )
+)
+ORDER BY st.created DESC, s.id
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
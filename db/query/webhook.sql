@@ -0,0 +1,80 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (
+  owner,
+  url,
+  events,
+  secret
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM webhooks
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+WHERE owner = $1
+ORDER BY id;
+
+-- name: ListActiveWebhooksForEvent :many
+SELECT * FROM webhooks
+WHERE owner = sqlc.arg(owner)
+  AND is_active = true
+  AND sqlc.arg(event)::varchar = ANY(events)
+ORDER BY id;
+
+-- name: UpdateWebhook :one
+UPDATE webhooks
+SET
+  url = COALESCE(sqlc.narg(url), url),
+  events = COALESCE(sqlc.narg(events), events),
+  secret = COALESCE(sqlc.narg(secret), secret),
+  is_active = COALESCE(sqlc.narg(is_active), is_active),
+  failure_count = CASE WHEN sqlc.narg(is_active)::boolean THEN 0 ELSE failure_count END
+WHERE
+  id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RecordWebhookFailure :one
+UPDATE webhooks
+SET
+  failure_count = failure_count + 1,
+  is_active = is_active AND failure_count + 1 < sqlc.arg(max_failures)::integer
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ResetWebhookFailures :exec
+UPDATE webhooks
+SET failure_count = 0
+WHERE id = $1;
+
+-- name: DeleteWebhook :exec
+DELETE FROM webhooks
+WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+  webhook_id,
+  event,
+  payload,
+  attempt,
+  status_code,
+  error,
+  succeeded
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createAccount = `-- name: CreateAccount :one
@@ -16,7 +17,7 @@ INSERT INTO account (
 ) VALUES (
   $1, $2
 )
-RETURNING id, login, username, created
+RETURNING id, login, username, created, organization_id
 `
 
 type CreateAccountParams struct {
@@ -32,6 +33,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Login,
 		&i.Username,
 		&i.Created,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -47,7 +49,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int32) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, login, username, created FROM account
+SELECT id, login, username, created, organization_id FROM account
 WHERE id = $1 LIMIT 1
 `
 
@@ -59,6 +61,105 @@ func (q *Queries) GetAccount(ctx context.Context, id int32) (Account, error) {
 		&i.Login,
 		&i.Username,
 		&i.Created,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listAccountsByLogin = `-- name: ListAccountsByLogin :many
+SELECT id, login, username, created, organization_id FROM account
+WHERE login = $1 AND organization_id IS NULL
+ORDER BY id
+`
+
+func (q *Queries) ListAccountsByLogin(ctx context.Context, login string) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByLogin, login)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Login,
+			&i.Username,
+			&i.Created,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByOrganization = `-- name: ListAccountsByOrganization :many
+SELECT id, login, username, created, organization_id FROM account
+WHERE organization_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAccountsByOrganization(ctx context.Context, organizationID sql.NullInt64) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Login,
+			&i.Username,
+			&i.Created,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transferAccount = `-- name: TransferAccount :one
+UPDATE account
+SET
+  login = $1,
+  organization_id = $2
+WHERE id = $3
+RETURNING id, login, username, created, organization_id
+`
+
+type TransferAccountParams struct {
+	Login          string        `json:"login"`
+	OrganizationID sql.NullInt64 `json:"organization_id"`
+	ID             int32         `json:"id"`
+}
+
+func (q *Queries) TransferAccount(ctx context.Context, arg TransferAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, transferAccount, arg.Login, arg.OrganizationID, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Login,
+		&i.Username,
+		&i.Created,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -67,7 +168,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE account
   set username = $2
 WHERE id = $1
-RETURNING id, login, username, created
+RETURNING id, login, username, created, organization_id
 `
 
 type UpdateAccountParams struct {
@@ -83,6 +184,7 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Login,
 		&i.Username,
 		&i.Created,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/scipiia/snippetbox/util"
+)
+
+// AccountRole returns the role of the user on the account. The user who owns
+// a personal account is its owner, the members of an organization have their
+// role on its accounts. Others get an empty role.
+func AccountRole(ctx context.Context, q Querier, account Account, username string) (string, error) {
+	if !account.OrganizationID.Valid {
+		if account.Login == username {
+			return util.OrgRoleOwner, nil
+		}
+		return "", nil
+	}
+
+	member, err := q.GetOrganizationMember(ctx, GetOrganizationMemberParams{
+		OrganizationID: account.OrganizationID.Int64,
+		Username:       username,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return member.Role, nil
+}
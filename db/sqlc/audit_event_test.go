@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAuditEvent(t *testing.T, actor string) AuditEvent {
+	arg := CreateAuditEventParams{
+		Actor:     actor,
+		Action:    util.AuditUserLogin,
+		Target:    "user:" + actor,
+		ClientIp:  "127.0.0.1",
+		UserAgent: "test",
+		Metadata:  json.RawMessage(`{"session_id":"` + util.RandomString(8) + `"}`),
+	}
+
+	event, err := testQueries.CreateAuditEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, event)
+
+	require.Equal(t, arg.Actor, event.Actor)
+	require.Equal(t, arg.Action, event.Action)
+	require.Equal(t, arg.Target, event.Target)
+	require.Equal(t, arg.ClientIp, event.ClientIp)
+	require.Equal(t, arg.UserAgent, event.UserAgent)
+	require.JSONEq(t, string(arg.Metadata), string(event.Metadata))
+	require.NotZero(t, event.Created)
+
+	return event
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	createRandomAuditEvent(t, util.RandomUser())
+}
+
+func TestSearchAuditEvents(t *testing.T) {
+	actor := util.RandomUser()
+	var last AuditEvent
+	for i := 0; i < 3; i++ {
+		last = createRandomAuditEvent(t, actor)
+	}
+	createRandomAuditEvent(t, util.RandomUser())
+
+	events, err := testQueries.SearchAuditEvents(context.Background(), SearchAuditEventsParams{
+		Actor:       sql.NullString{String: actor, Valid: true},
+		Since:       sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+		LimitCount:  2,
+		OffsetCount: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, last.ID, events[0].ID)
+	for _, event := range events {
+		require.Equal(t, actor, event.Actor)
+	}
+}
+
+func TestListUserAuditEvents(t *testing.T) {
+	user := util.RandomUser()
+	own := createRandomAuditEvent(t, user)
+
+	// a failed login on the user by an unauthenticated caller
+	failed, err := testQueries.CreateAuditEvent(context.Background(), CreateAuditEventParams{
+		Actor:    util.AnonymousActor,
+		Action:   util.AuditUserLoginFailed,
+		Target:   util.AuditTarget("user", user),
+		Metadata: json.RawMessage(`{}`),
+	})
+	require.NoError(t, err)
+	createRandomAuditEvent(t, util.RandomUser())
+
+	events, err := testQueries.ListUserAuditEvents(context.Background(), ListUserAuditEventsParams{
+		Actor:      user,
+		Target:     util.AuditTarget("user", user),
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, failed.ID, events[0].ID)
+	require.Equal(t, own.ID, events[1].ID)
+}
+
+func TestExportAuditEvents(t *testing.T) {
+	actor := util.RandomUser()
+	first := createRandomAuditEvent(t, actor)
+	second := createRandomAuditEvent(t, actor)
+
+	events, err := testQueries.ExportAuditEvents(context.Background(), ExportAuditEventsParams{
+		AfterID:    first.ID,
+		Actor:      sql.NullString{String: actor, Valid: true},
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, second.ID, events[0].ID)
+}
+
+func TestAuditEventsAppendOnly(t *testing.T) {
+	event := createRandomAuditEvent(t, util.RandomUser())
+
+	_, err := testQueries.db.ExecContext(context.Background(), "DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.Error(t, err)
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCollection(t *testing.T, account Account, parent sql.NullInt64) Collection {
+	arg := CreateCollectionParams{
+		AccountID: account.ID,
+		ParentID:  parent,
+		Name:      util.RandomString(8),
+	}
+
+	collection, err := testQueries.CreateCollection(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, collection.AccountID)
+	require.Equal(t, arg.ParentID, collection.ParentID)
+	require.Equal(t, arg.Name, collection.Name)
+	require.NotZero(t, collection.Created)
+
+	return collection
+}
+
+func TestCollectionTree(t *testing.T) {
+	account := createRandomAccount(t)
+	root := createRandomCollection(t, account, sql.NullInt64{})
+	child := createRandomCollection(t, account, sql.NullInt64{Int64: root.ID, Valid: true})
+	grandchild := createRandomCollection(t, account, sql.NullInt64{Int64: child.ID, Valid: true})
+
+	depth, err := testQueries.GetCollectionDepth(context.Background(), GetCollectionDepthParams{
+		ID:       grandchild.ID,
+		MaxDepth: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), depth)
+
+	subtree, err := testQueries.ListCollectionSubtree(context.Background(), ListCollectionSubtreeParams{
+		ID:       root.ID,
+		MaxLevel: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, subtree, 3)
+	require.Equal(t, root.ID, subtree[0].ID)
+	require.Equal(t, int32(1), subtree[0].Level)
+
+	// the walks stop at the cap
+	depth, err = testQueries.GetCollectionDepth(context.Background(), GetCollectionDepthParams{
+		ID:       grandchild.ID,
+		MaxDepth: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), depth)
+
+	subtree, err = testQueries.ListCollectionSubtree(context.Background(), ListCollectionSubtreeParams{
+		ID:       root.ID,
+		MaxLevel: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, subtree, 2)
+
+	// siblings need distinct names
+	_, err = testQueries.CreateCollection(context.Background(), CreateCollectionParams{
+		AccountID: account.ID,
+		Name:      root.Name,
+	})
+	require.Error(t, err)
+
+	moved, err := testQueries.MoveCollection(context.Background(), MoveCollectionParams{
+		ID: grandchild.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, moved.ParentID.Valid)
+
+	collections, err := testQueries.ListCollections(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, collections, 3)
+
+	// sub collections go with their parent
+	err = testQueries.DeleteCollection(context.Background(), root.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetCollection(context.Background(), child.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestMoveCollectionTx(t *testing.T) {
+	account := createRandomAccount(t)
+	root := createRandomCollection(t, account, sql.NullInt64{})
+	child := createRandomCollection(t, account, sql.NullInt64{Int64: root.ID, Valid: true})
+	grandchild := createRandomCollection(t, account, sql.NullInt64{Int64: child.ID, Valid: true})
+	other := createRandomCollection(t, account, sql.NullInt64{})
+
+	// a collection can't move below its own sub collection
+	_, err := testStore.MoveCollectionTx(context.Background(), MoveCollectionTxParams{
+		ID:        root.ID,
+		AccountID: account.ID,
+		ParentID:  sql.NullInt64{Int64: grandchild.ID, Valid: true},
+		MaxDepth:  10,
+	})
+	require.ErrorIs(t, err, ErrCollectionCycle)
+
+	// root with its two levels below other would be 4 deep
+	_, err = testStore.MoveCollectionTx(context.Background(), MoveCollectionTxParams{
+		ID:        root.ID,
+		AccountID: account.ID,
+		ParentID:  sql.NullInt64{Int64: other.ID, Valid: true},
+		MaxDepth:  3,
+	})
+	require.ErrorIs(t, err, ErrCollectionTooDeep)
+
+	moved, err := testStore.MoveCollectionTx(context.Background(), MoveCollectionTxParams{
+		ID:        root.ID,
+		AccountID: account.ID,
+		ParentID:  sql.NullInt64{Int64: other.ID, Valid: true},
+		MaxDepth:  4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, other.ID, moved.ParentID.Int64)
+
+	moved, err = testStore.MoveCollectionTx(context.Background(), MoveCollectionTxParams{
+		ID:        root.ID,
+		AccountID: account.ID,
+		MaxDepth:  4,
+	})
+	require.NoError(t, err)
+	require.False(t, moved.ParentID.Valid)
+}
+
+func TestCollectionSnippets(t *testing.T) {
+	account := createRandomAccount(t)
+	collection := createRandomCollection(t, account, sql.NullInt64{})
+
+	var snippets []Snippet
+	for i := 0; i < 3; i++ {
+		snippet := createRandomSnippet(t, account)
+		snippets = append(snippets, snippet)
+
+		member, err := testQueries.AddCollectionSnippet(context.Background(), AddCollectionSnippetParams{
+			CollectionID: collection.ID,
+			SnippetID:    snippet.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(i+1), member.Position)
+	}
+
+	err := testQueries.UpdateCollectionSnippetPosition(context.Background(), UpdateCollectionSnippetPositionParams{
+		Position:     0,
+		CollectionID: collection.ID,
+		SnippetID:    snippets[2].ID,
+	})
+	require.NoError(t, err)
+
+	listed, err := testQueries.ListSnippetsByCollection(context.Background(), ListSnippetsByCollectionParams{
+		CollectionID: collection.ID,
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, listed, 3)
+	require.Equal(t, snippets[2].ID, listed[0].ID)
+
+	_, err = testQueries.RemoveCollectionSnippet(context.Background(), RemoveCollectionSnippetParams{
+		CollectionID: collection.ID,
+		SnippetID:    snippets[0].ID,
+	})
+	require.NoError(t, err)
+
+	// deleting the collection keeps the snippets
+	err = testQueries.DeleteCollection(context.Background(), collection.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetSnippet(context.Background(), snippets[1].ID)
+	require.NoError(t, err)
+}
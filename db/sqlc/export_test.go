@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomExport(t *testing.T) Export {
+	user := createRandomUser(t)
+
+	export, err := testQueries.CreateExport(context.Background(), user.Name)
+	require.NoError(t, err)
+	require.NotEmpty(t, export)
+
+	require.Equal(t, user.Name, export.Owner)
+	require.Equal(t, util.ExportStatusPending, export.Status)
+	require.False(t, export.BlobKey.Valid)
+	require.Zero(t, export.Size)
+	require.False(t, export.FinishedAt.Valid)
+	require.False(t, export.ExpiresAt.Valid)
+
+	return export
+}
+
+func TestCreateExport(t *testing.T) {
+	createRandomExport(t)
+}
+
+func TestGetExport(t *testing.T) {
+	export1 := createRandomExport(t)
+
+	export2, err := testQueries.GetExport(context.Background(), export1.ID)
+	require.NoError(t, err)
+	require.Equal(t, export1.ID, export2.ID)
+	require.Equal(t, export1.Owner, export2.Owner)
+	require.WithinDuration(t, export1.Created, export2.Created, time.Second)
+}
+
+func TestFinishAndExpireExport(t *testing.T) {
+	export := createRandomExport(t)
+
+	arg := FinishExportParams{
+		Status:    util.ExportStatusCompleted,
+		BlobKey:   sql.NullString{String: util.RandomString(12), Valid: true},
+		Size:      1024,
+		ExpiresAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+		ID:        export.ID,
+	}
+
+	finished, err := testQueries.FinishExport(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Status, finished.Status)
+	require.Equal(t, arg.BlobKey, finished.BlobKey)
+	require.Equal(t, arg.Size, finished.Size)
+	require.True(t, finished.FinishedAt.Valid)
+	require.WithinDuration(t, arg.ExpiresAt.Time, finished.ExpiresAt.Time, time.Second)
+
+	expired, err := testQueries.ListExpiredExports(context.Background(), ListExpiredExportsParams{
+		Before:     time.Now(),
+		LimitCount: 1000,
+	})
+	require.NoError(t, err)
+
+	var found bool
+	for _, item := range expired {
+		if item.ID == export.ID {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	err = testQueries.ExpireExport(context.Background(), export.ID)
+	require.NoError(t, err)
+
+	export, err = testQueries.GetExport(context.Background(), export.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ExportStatusExpired, export.Status)
+	require.False(t, export.BlobKey.Valid)
+}
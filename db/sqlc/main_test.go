@@ -16,6 +16,7 @@ import (
 // )
 
 var testQueries *Queries
+var testStore Store
 
 func TestMain(m *testing.M) {
 
@@ -30,6 +31,7 @@ func TestMain(m *testing.M) {
 	}
 
 	testQueries = New(conn)
+	testStore = NewStore(conn)
 
 	os.Exit(m.Run())
 }
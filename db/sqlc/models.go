@@ -5,16 +5,99 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Account struct {
-	ID       int32     `json:"id"`
-	Login    string    `json:"login"`
-	Username string    `json:"username"`
-	Created  time.Time `json:"created"`
+	ID             int32         `json:"id"`
+	Login          string        `json:"login"`
+	Username       string        `json:"username"`
+	Created        time.Time     `json:"created"`
+	OrganizationID sql.NullInt64 `json:"organization_id"`
+}
+
+type AuditEvent struct {
+	ID        int64           `json:"id"`
+	Actor     string          `json:"actor"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	ClientIp  string          `json:"client_ip"`
+	UserAgent string          `json:"user_agent"`
+	Metadata  json.RawMessage `json:"metadata"`
+	Created   time.Time       `json:"created"`
+}
+
+type Collection struct {
+	ID        int64         `json:"id"`
+	AccountID int32         `json:"account_id"`
+	ParentID  sql.NullInt64 `json:"parent_id"`
+	Name      string        `json:"name"`
+	Created   time.Time     `json:"created"`
+}
+
+type CollectionSnippet struct {
+	CollectionID int64     `json:"collection_id"`
+	SnippetID    int32     `json:"snippet_id"`
+	Position     int32     `json:"position"`
+	Created      time.Time `json:"created"`
+}
+
+type Export struct {
+	ID         int64          `json:"id"`
+	Owner      string         `json:"owner"`
+	Status     string         `json:"status"`
+	BlobKey    sql.NullString `json:"blob_key"`
+	Size       int64          `json:"size"`
+	Error      sql.NullString `json:"error"`
+	Created    time.Time      `json:"created"`
+	FinishedAt sql.NullTime   `json:"finished_at"`
+	ExpiresAt  sql.NullTime   `json:"expires_at"`
+}
+
+type Organization struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Created     time.Time `json:"created"`
+}
+
+type OrganizationInvitation struct {
+	ID             int64        `json:"id"`
+	OrganizationID int64        `json:"organization_id"`
+	Invitee        string       `json:"invitee"`
+	Role           string       `json:"role"`
+	InvitedBy      string       `json:"invited_by"`
+	Status         string       `json:"status"`
+	Created        time.Time    `json:"created"`
+	ExpiresAt      time.Time    `json:"expires_at"`
+	RespondedAt    sql.NullTime `json:"responded_at"`
+}
+
+type OrganizationMember struct {
+	OrganizationID int64     `json:"organization_id"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+	Created        time.Time `json:"created"`
+}
+
+type Outbox struct {
+	ID            int64           `json:"id"`
+	TaskType      string          `json:"task_type"`
+	Payload       json.RawMessage `json:"payload"`
+	Queue         string          `json:"queue"`
+	MaxRetry      int32           `json:"max_retry"`
+	ProcessAt     sql.NullTime    `json:"process_at"`
+	Attempts      int32           `json:"attempts"`
+	MaxAttempts   int32           `json:"max_attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	LastError     sql.NullString  `json:"last_error"`
+	PublishedAt   sql.NullTime    `json:"published_at"`
+	DeadAt        sql.NullTime    `json:"dead_at"`
+	Created       time.Time       `json:"created"`
 }
 
 type Session struct {
@@ -29,10 +112,61 @@ type Session struct {
 }
 
 type Snippet struct {
-	ID        int32     `json:"id"`
-	AccountID int32     `json:"account_id"`
-	Title     string    `json:"title"`
+	ID         int32         `json:"id"`
+	AccountID  int32         `json:"account_id"`
+	Title      string        `json:"title"`
+	Created    time.Time     `json:"created"`
+	Language   string        `json:"language"`
+	IsPublic   bool          `json:"is_public"`
+	ForkedFrom sql.NullInt32 `json:"forked_from"`
+}
+
+type SnippetComment struct {
+	ID        int64          `json:"id"`
+	SnippetID int32          `json:"snippet_id"`
+	ParentID  sql.NullInt64  `json:"parent_id"`
+	Author    string         `json:"author"`
+	Body      string         `json:"body"`
+	LineStart sql.NullInt32  `json:"line_start"`
+	LineEnd   sql.NullInt32  `json:"line_end"`
+	Created   time.Time      `json:"created"`
+	EditedAt  sql.NullTime   `json:"edited_at"`
+	DeletedAt sql.NullTime   `json:"deleted_at"`
+	DeletedBy sql.NullString `json:"deleted_by"`
+	Filename  sql.NullString `json:"filename"`
+}
+
+type SnippetFile struct {
+	ID        int64     `json:"id"`
+	SnippetID int32     `json:"snippet_id"`
+	Filename  string    `json:"filename"`
+	Language  string    `json:"language"`
 	Content   string    `json:"content"`
+	Position  int32     `json:"position"`
+	Created   time.Time `json:"created"`
+}
+
+type SnippetImport struct {
+	ID              int64           `json:"id"`
+	Owner           string          `json:"owner"`
+	AccountID       int32           `json:"account_id"`
+	Format          string          `json:"format"`
+	DuplicatePolicy string          `json:"duplicate_policy"`
+	Status          string          `json:"status"`
+	Archive         []byte          `json:"archive"`
+	Total           int32           `json:"total"`
+	CreatedCount    int32           `json:"created_count"`
+	UpdatedCount    int32           `json:"updated_count"`
+	SkippedCount    int32           `json:"skipped_count"`
+	FailedCount     int32           `json:"failed_count"`
+	Errors          json.RawMessage `json:"errors"`
+	Created         time.Time       `json:"created"`
+	FinishedAt      sql.NullTime    `json:"finished_at"`
+}
+
+type SnippetStar struct {
+	Username  string    `json:"username"`
+	SnippetID int32     `json:"snippet_id"`
 	Created   time.Time `json:"created"`
 }
 
@@ -43,4 +177,28 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	Created           time.Time `json:"created"`
+	Role              string    `json:"role"`
+}
+
+type Webhook struct {
+	ID           int64     `json:"id"`
+	Owner        string    `json:"owner"`
+	Url          string    `json:"url"`
+	Events       []string  `json:"events"`
+	Secret       string    `json:"secret"`
+	IsActive     bool      `json:"is_active"`
+	FailureCount int32     `json:"failure_count"`
+	Created      time.Time `json:"created"`
+}
+
+type WebhookDelivery struct {
+	ID         int64           `json:"id"`
+	WebhookID  int64           `json:"webhook_id"`
+	Event      string          `json:"event"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempt    int32           `json:"attempt"`
+	StatusCode sql.NullInt32   `json:"status_code"`
+	Error      sql.NullString  `json:"error"`
+	Succeeded  bool            `json:"succeeded"`
+	Created    time.Time       `json:"created"`
 }
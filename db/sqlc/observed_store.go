@@ -0,0 +1,805 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StoreObserver is called when a store method starts, the returned function
+// is called with the error of the method when it returns. It may return a
+// derived context which is passed on to the method.
+type StoreObserver func(ctx context.Context, method string) (context.Context, func(err error))
+
+// ObservedStore calls the observers around every method of a Store, it is
+// used for metrics and tracing. New store methods must be added here too.
+type ObservedStore struct {
+	store     Store
+	observers []StoreObserver
+}
+
+func NewObservedStore(store Store, observers ...StoreObserver) Store {
+	return &ObservedStore{
+		store:     store,
+		observers: observers,
+	}
+}
+
+// observe runs the observers in order and finishes them in reverse order
+func (store *ObservedStore) observe(ctx context.Context, method string) (context.Context, func(err error)) {
+	dones := make([]func(err error), len(store.observers))
+	for i, observer := range store.observers {
+		ctx, dones[i] = observer(ctx, method)
+	}
+
+	return ctx, func(err error) {
+		for i := len(dones) - 1; i >= 0; i-- {
+			dones[i](err)
+		}
+	}
+}
+
+func (store *ObservedStore) AddCollectionSnippet(ctx context.Context, arg AddCollectionSnippetParams) (CollectionSnippet, error) {
+	ctx, done := store.observe(ctx, "AddCollectionSnippet")
+	result, err := store.store.AddCollectionSnippet(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) BlockSession(ctx context.Context, id uuid.UUID) (Session, error) {
+	ctx, done := store.observe(ctx, "BlockSession")
+	result, err := store.store.BlockSession(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ClearStaleSnippetCommentAnchors(ctx context.Context, snippetID int32) error {
+	ctx, done := store.observe(ctx, "ClearStaleSnippetCommentAnchors")
+	err := store.store.ClearStaleSnippetCommentAnchors(ctx, snippetID)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) CopySnippetFiles(ctx context.Context, arg CopySnippetFilesParams) error {
+	ctx, done := store.observe(ctx, "CopySnippetFiles")
+	err := store.store.CopySnippetFiles(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) CountSnippetForks(ctx context.Context, forkedFrom sql.NullInt32) (int64, error) {
+	ctx, done := store.observe(ctx, "CountSnippetForks")
+	result, err := store.store.CountSnippetForks(ctx, forkedFrom)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CountSnippetStars(ctx context.Context, snippetID int32) (int64, error) {
+	ctx, done := store.observe(ctx, "CountSnippetStars")
+	result, err := store.store.CountSnippetStars(ctx, snippetID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	ctx, done := store.observe(ctx, "CreateAccount")
+	result, err := store.store.CreateAccount(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	ctx, done := store.observe(ctx, "CreateAuditEvent")
+	result, err := store.store.CreateAuditEvent(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateCollection(ctx context.Context, arg CreateCollectionParams) (Collection, error) {
+	ctx, done := store.observe(ctx, "CreateCollection")
+	result, err := store.store.CreateCollection(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateExport(ctx context.Context, owner string) (Export, error) {
+	ctx, done := store.observe(ctx, "CreateExport")
+	result, err := store.store.CreateExport(ctx, owner)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error) {
+	ctx, done := store.observe(ctx, "CreateOrganization")
+	result, err := store.store.CreateOrganization(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitation, error) {
+	ctx, done := store.observe(ctx, "CreateOrganizationInvitation")
+	result, err := store.store.CreateOrganizationInvitation(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) (OrganizationMember, error) {
+	ctx, done := store.observe(ctx, "CreateOrganizationMember")
+	result, err := store.store.CreateOrganizationMember(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateOutboxTask(ctx context.Context, arg CreateOutboxTaskParams) (Outbox, error) {
+	ctx, done := store.observe(ctx, "CreateOutboxTask")
+	result, err := store.store.CreateOutboxTask(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	ctx, done := store.observe(ctx, "CreateSession")
+	result, err := store.store.CreateSession(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippet(ctx context.Context, arg CreateSnippetParams) (Snippet, error) {
+	ctx, done := store.observe(ctx, "CreateSnippet")
+	result, err := store.store.CreateSnippet(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetComment(ctx context.Context, arg CreateSnippetCommentParams) (SnippetComment, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetComment")
+	result, err := store.store.CreateSnippetComment(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetFork(ctx context.Context, arg CreateSnippetForkParams) (Snippet, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetFork")
+	result, err := store.store.CreateSnippetFork(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetImport(ctx context.Context, arg CreateSnippetImportParams) (SnippetImport, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetImport")
+	result, err := store.store.CreateSnippetImport(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	ctx, done := store.observe(ctx, "CreateUser")
+	result, err := store.store.CreateUser(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	ctx, done := store.observe(ctx, "CreateWebhook")
+	result, err := store.store.CreateWebhook(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	ctx, done := store.observe(ctx, "CreateWebhookDelivery")
+	result, err := store.store.CreateWebhookDelivery(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) DeleteAccount(ctx context.Context, id int32) error {
+	ctx, done := store.observe(ctx, "DeleteAccount")
+	err := store.store.DeleteAccount(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) DeleteCollection(ctx context.Context, id int64) error {
+	ctx, done := store.observe(ctx, "DeleteCollection")
+	err := store.store.DeleteCollection(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	ctx, done := store.observe(ctx, "DeleteOrganizationMember")
+	err := store.store.DeleteOrganizationMember(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) DeleteOtherSnippetFiles(ctx context.Context, arg DeleteOtherSnippetFilesParams) error {
+	ctx, done := store.observe(ctx, "DeleteOtherSnippetFiles")
+	err := store.store.DeleteOtherSnippetFiles(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) DeletePublishedOutboxTasks(ctx context.Context, before time.Time) (int64, error) {
+	ctx, done := store.observe(ctx, "DeletePublishedOutboxTasks")
+	result, err := store.store.DeletePublishedOutboxTasks(ctx, before)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) DeleteSnippet(ctx context.Context, id int32) (Snippet, error) {
+	ctx, done := store.observe(ctx, "DeleteSnippet")
+	result, err := store.store.DeleteSnippet(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) DeleteSnippetComment(ctx context.Context, arg DeleteSnippetCommentParams) (SnippetComment, error) {
+	ctx, done := store.observe(ctx, "DeleteSnippetComment")
+	result, err := store.store.DeleteSnippetComment(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) DeleteWebhook(ctx context.Context, id int64) error {
+	ctx, done := store.observe(ctx, "DeleteWebhook")
+	err := store.store.DeleteWebhook(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) ExpireExport(ctx context.Context, id int64) error {
+	ctx, done := store.observe(ctx, "ExpireExport")
+	err := store.store.ExpireExport(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) ExportAuditEvents(ctx context.Context, arg ExportAuditEventsParams) ([]AuditEvent, error) {
+	ctx, done := store.observe(ctx, "ExportAuditEvents")
+	result, err := store.store.ExportAuditEvents(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) FinishExport(ctx context.Context, arg FinishExportParams) (Export, error) {
+	ctx, done := store.observe(ctx, "FinishExport")
+	result, err := store.store.FinishExport(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) FinishSnippetImport(ctx context.Context, arg FinishSnippetImportParams) (SnippetImport, error) {
+	ctx, done := store.observe(ctx, "FinishSnippetImport")
+	result, err := store.store.FinishSnippetImport(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetAccount(ctx context.Context, id int32) (Account, error) {
+	ctx, done := store.observe(ctx, "GetAccount")
+	result, err := store.store.GetAccount(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetCollection(ctx context.Context, id int64) (Collection, error) {
+	ctx, done := store.observe(ctx, "GetCollection")
+	result, err := store.store.GetCollection(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetCollectionDepth(ctx context.Context, arg GetCollectionDepthParams) (int32, error) {
+	ctx, done := store.observe(ctx, "GetCollectionDepth")
+	result, err := store.store.GetCollectionDepth(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetExport(ctx context.Context, id int64) (Export, error) {
+	ctx, done := store.observe(ctx, "GetExport")
+	result, err := store.store.GetExport(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetOrganization(ctx context.Context, id int64) (Organization, error) {
+	ctx, done := store.observe(ctx, "GetOrganization")
+	result, err := store.store.GetOrganization(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetOrganizationInvitation(ctx context.Context, id int64) (OrganizationInvitation, error) {
+	ctx, done := store.observe(ctx, "GetOrganizationInvitation")
+	result, err := store.store.GetOrganizationInvitation(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error) {
+	ctx, done := store.observe(ctx, "GetOrganizationMember")
+	result, err := store.store.GetOrganizationMember(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSession(ctx context.Context, id uuid.UUID) (Session, error) {
+	ctx, done := store.observe(ctx, "GetSession")
+	result, err := store.store.GetSession(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSnippet(ctx context.Context, id int32) (Snippet, error) {
+	ctx, done := store.observe(ctx, "GetSnippet")
+	result, err := store.store.GetSnippet(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSnippetByTitle(ctx context.Context, arg GetSnippetByTitleParams) (Snippet, error) {
+	ctx, done := store.observe(ctx, "GetSnippetByTitle")
+	result, err := store.store.GetSnippetByTitle(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSnippetComment(ctx context.Context, id int64) (SnippetComment, error) {
+	ctx, done := store.observe(ctx, "GetSnippetComment")
+	result, err := store.store.GetSnippetComment(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSnippetFile(ctx context.Context, arg GetSnippetFileParams) (SnippetFile, error) {
+	ctx, done := store.observe(ctx, "GetSnippetFile")
+	result, err := store.store.GetSnippetFile(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetSnippetImport(ctx context.Context, id int64) (SnippetImport, error) {
+	ctx, done := store.observe(ctx, "GetSnippetImport")
+	result, err := store.store.GetSnippetImport(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetUser(ctx context.Context, name string) (User, error) {
+	ctx, done := store.observe(ctx, "GetUser")
+	result, err := store.store.GetUser(ctx, name)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetWebhook(ctx context.Context, id int64) (Webhook, error) {
+	ctx, done := store.observe(ctx, "GetWebhook")
+	result, err := store.store.GetWebhook(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	ctx, done := store.observe(ctx, "GetWebhookDelivery")
+	result, err := store.store.GetWebhookDelivery(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) IsSnippetStarred(ctx context.Context, arg IsSnippetStarredParams) (bool, error) {
+	ctx, done := store.observe(ctx, "IsSnippetStarred")
+	result, err := store.store.IsSnippetStarred(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListAccountsByLogin(ctx context.Context, login string) ([]Account, error) {
+	ctx, done := store.observe(ctx, "ListAccountsByLogin")
+	result, err := store.store.ListAccountsByLogin(ctx, login)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListAccountsByOrganization(ctx context.Context, organizationID sql.NullInt64) ([]Account, error) {
+	ctx, done := store.observe(ctx, "ListAccountsByOrganization")
+	result, err := store.store.ListAccountsByOrganization(ctx, organizationID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListActiveWebhooksForEvent(ctx context.Context, arg ListActiveWebhooksForEventParams) ([]Webhook, error) {
+	ctx, done := store.observe(ctx, "ListActiveWebhooksForEvent")
+	result, err := store.store.ListActiveWebhooksForEvent(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListCollectionSnippetIDs(ctx context.Context, collectionID int64) ([]int32, error) {
+	ctx, done := store.observe(ctx, "ListCollectionSnippetIDs")
+	result, err := store.store.ListCollectionSnippetIDs(ctx, collectionID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListCollectionSubtree(ctx context.Context, arg ListCollectionSubtreeParams) ([]ListCollectionSubtreeRow, error) {
+	ctx, done := store.observe(ctx, "ListCollectionSubtree")
+	result, err := store.store.ListCollectionSubtree(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListCollections(ctx context.Context, accountID int32) ([]Collection, error) {
+	ctx, done := store.observe(ctx, "ListCollections")
+	result, err := store.store.ListCollections(ctx, accountID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListExpiredExports(ctx context.Context, arg ListExpiredExportsParams) ([]Export, error) {
+	ctx, done := store.observe(ctx, "ListExpiredExports")
+	result, err := store.store.ListExpiredExports(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListOrganizationMembers(ctx context.Context, organizationID int64) ([]OrganizationMember, error) {
+	ctx, done := store.observe(ctx, "ListOrganizationMembers")
+	result, err := store.store.ListOrganizationMembers(ctx, organizationID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListOrganizationsByMember(ctx context.Context, username string) ([]ListOrganizationsByMemberRow, error) {
+	ctx, done := store.observe(ctx, "ListOrganizationsByMember")
+	result, err := store.store.ListOrganizationsByMember(ctx, username)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListPendingInvitationsByInvitee(ctx context.Context, invitee string) ([]OrganizationInvitation, error) {
+	ctx, done := store.observe(ctx, "ListPendingInvitationsByInvitee")
+	result, err := store.store.ListPendingInvitationsByInvitee(ctx, invitee)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListPendingOutboxTasks(ctx context.Context, limit int32) ([]Outbox, error) {
+	ctx, done := store.observe(ctx, "ListPendingOutboxTasks")
+	result, err := store.store.ListPendingOutboxTasks(ctx, limit)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSessionsByName(ctx context.Context, name string) ([]Session, error) {
+	ctx, done := store.observe(ctx, "ListSessionsByName")
+	result, err := store.store.ListSessionsByName(ctx, name)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSnippetComments(ctx context.Context, arg ListSnippetCommentsParams) ([]SnippetComment, error) {
+	ctx, done := store.observe(ctx, "ListSnippetComments")
+	result, err := store.store.ListSnippetComments(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSnippetFiles(ctx context.Context, snippetID int32) ([]SnippetFile, error) {
+	ctx, done := store.observe(ctx, "ListSnippetFiles")
+	result, err := store.store.ListSnippetFiles(ctx, snippetID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSnippetForks(ctx context.Context, arg ListSnippetForksParams) ([]Snippet, error) {
+	ctx, done := store.observe(ctx, "ListSnippetForks")
+	result, err := store.store.ListSnippetForks(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSnippets(ctx context.Context, arg ListSnippetsParams) ([]Snippet, error) {
+	ctx, done := store.observe(ctx, "ListSnippets")
+	result, err := store.store.ListSnippets(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListSnippetsByCollection(ctx context.Context, arg ListSnippetsByCollectionParams) ([]Snippet, error) {
+	ctx, done := store.observe(ctx, "ListSnippetsByCollection")
+	result, err := store.store.ListSnippetsByCollection(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListStarredSnippets(ctx context.Context, arg ListStarredSnippetsParams) ([]Snippet, error) {
+	ctx, done := store.observe(ctx, "ListStarredSnippets")
+	result, err := store.store.ListStarredSnippets(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListUserAuditEvents(ctx context.Context, arg ListUserAuditEventsParams) ([]AuditEvent, error) {
+	ctx, done := store.observe(ctx, "ListUserAuditEvents")
+	result, err := store.store.ListUserAuditEvents(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	ctx, done := store.observe(ctx, "ListWebhookDeliveries")
+	result, err := store.store.ListWebhookDeliveries(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ListWebhooks(ctx context.Context, owner string) ([]Webhook, error) {
+	ctx, done := store.observe(ctx, "ListWebhooks")
+	result, err := store.store.ListWebhooks(ctx, owner)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) LockAccountCollections(ctx context.Context, accountID int32) error {
+	ctx, done := store.observe(ctx, "LockAccountCollections")
+	err := store.store.LockAccountCollections(ctx, accountID)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) LockOrganizationOwners(ctx context.Context, organizationID int64) ([]string, error) {
+	ctx, done := store.observe(ctx, "LockOrganizationOwners")
+	result, err := store.store.LockOrganizationOwners(ctx, organizationID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) MarkOutboxTaskFailed(ctx context.Context, arg MarkOutboxTaskFailedParams) (Outbox, error) {
+	ctx, done := store.observe(ctx, "MarkOutboxTaskFailed")
+	result, err := store.store.MarkOutboxTaskFailed(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) MarkOutboxTaskPublished(ctx context.Context, id int64) error {
+	ctx, done := store.observe(ctx, "MarkOutboxTaskPublished")
+	err := store.store.MarkOutboxTaskPublished(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) MoveCollection(ctx context.Context, arg MoveCollectionParams) (Collection, error) {
+	ctx, done := store.observe(ctx, "MoveCollection")
+	result, err := store.store.MoveCollection(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (Webhook, error) {
+	ctx, done := store.observe(ctx, "RecordWebhookFailure")
+	result, err := store.store.RecordWebhookFailure(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) RemoveCollectionSnippet(ctx context.Context, arg RemoveCollectionSnippetParams) (CollectionSnippet, error) {
+	ctx, done := store.observe(ctx, "RemoveCollectionSnippet")
+	result, err := store.store.RemoveCollectionSnippet(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) RenameCollection(ctx context.Context, arg RenameCollectionParams) (Collection, error) {
+	ctx, done := store.observe(ctx, "RenameCollection")
+	result, err := store.store.RenameCollection(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ResetWebhookFailures(ctx context.Context, id int64) error {
+	ctx, done := store.observe(ctx, "ResetWebhookFailures")
+	err := store.store.ResetWebhookFailures(ctx, id)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) RespondOrganizationInvitation(ctx context.Context, arg RespondOrganizationInvitationParams) (OrganizationInvitation, error) {
+	ctx, done := store.observe(ctx, "RespondOrganizationInvitation")
+	result, err := store.store.RespondOrganizationInvitation(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) SearchAuditEvents(ctx context.Context, arg SearchAuditEventsParams) ([]AuditEvent, error) {
+	ctx, done := store.observe(ctx, "SearchAuditEvents")
+	result, err := store.store.SearchAuditEvents(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) StarSnippet(ctx context.Context, arg StarSnippetParams) error {
+	ctx, done := store.observe(ctx, "StarSnippet")
+	err := store.store.StarSnippet(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) StartSnippetImport(ctx context.Context, id int64) (SnippetImport, error) {
+	ctx, done := store.observe(ctx, "StartSnippetImport")
+	result, err := store.store.StartSnippetImport(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) TransferAccount(ctx context.Context, arg TransferAccountParams) (Account, error) {
+	ctx, done := store.observe(ctx, "TransferAccount")
+	result, err := store.store.TransferAccount(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UnstarSnippet(ctx context.Context, arg UnstarSnippetParams) error {
+	ctx, done := store.observe(ctx, "UnstarSnippet")
+	err := store.store.UnstarSnippet(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
+	ctx, done := store.observe(ctx, "UpdateAccount")
+	result, err := store.store.UpdateAccount(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateCollectionSnippetPosition(ctx context.Context, arg UpdateCollectionSnippetPositionParams) error {
+	ctx, done := store.observe(ctx, "UpdateCollectionSnippetPosition")
+	err := store.store.UpdateCollectionSnippetPosition(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMember, error) {
+	ctx, done := store.observe(ctx, "UpdateOrganizationMemberRole")
+	result, err := store.store.UpdateOrganizationMemberRole(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateSnippet(ctx context.Context, arg UpdateSnippetParams) (Snippet, error) {
+	ctx, done := store.observe(ctx, "UpdateSnippet")
+	result, err := store.store.UpdateSnippet(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateSnippetComment(ctx context.Context, arg UpdateSnippetCommentParams) (SnippetComment, error) {
+	ctx, done := store.observe(ctx, "UpdateSnippetComment")
+	result, err := store.store.UpdateSnippetComment(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	ctx, done := store.observe(ctx, "UpdateUser")
+	result, err := store.store.UpdateUser(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
+	ctx, done := store.observe(ctx, "UpdateWebhook")
+	result, err := store.store.UpdateWebhook(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpsertSnippetFile(ctx context.Context, arg UpsertSnippetFileParams) (SnippetFile, error) {
+	ctx, done := store.observe(ctx, "UpsertSnippetFile")
+	result, err := store.store.UpsertSnippetFile(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateUserTx")
+	result, err := store.store.CreateUserTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetImportTx(ctx context.Context, arg CreateSnippetImportTxParams) (CreateSnippetImportTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetImportTx")
+	result, err := store.store.CreateSnippetImportTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateExportTx(ctx context.Context, arg CreateExportTxParams) (CreateExportTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateExportTx")
+	result, err := store.store.CreateExportTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) PublishOutboxTx(ctx context.Context, arg PublishOutboxTxParams) (PublishOutboxTxResult, error) {
+	ctx, done := store.observe(ctx, "PublishOutboxTx")
+	result, err := store.store.PublishOutboxTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateOrganizationTx(ctx context.Context, arg CreateOrganizationTxParams) (CreateOrganizationTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateOrganizationTx")
+	result, err := store.store.CreateOrganizationTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) AcceptOrganizationInvitationTx(ctx context.Context, invitationID int64) (AcceptOrganizationInvitationTxResult, error) {
+	ctx, done := store.observe(ctx, "AcceptOrganizationInvitationTx")
+	result, err := store.store.AcceptOrganizationInvitationTx(ctx, invitationID)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateOrganizationMemberRoleTx(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMember, error) {
+	ctx, done := store.observe(ctx, "UpdateOrganizationMemberRoleTx")
+	result, err := store.store.UpdateOrganizationMemberRoleTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) RemoveOrganizationMemberTx(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	ctx, done := store.observe(ctx, "RemoveOrganizationMemberTx")
+	err := store.store.RemoveOrganizationMemberTx(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) ReorderCollectionTx(ctx context.Context, arg ReorderCollectionTxParams) error {
+	ctx, done := store.observe(ctx, "ReorderCollectionTx")
+	err := store.store.ReorderCollectionTx(ctx, arg)
+	done(err)
+	return err
+}
+
+func (store *ObservedStore) MoveCollectionTx(ctx context.Context, arg MoveCollectionTxParams) (Collection, error) {
+	ctx, done := store.observe(ctx, "MoveCollectionTx")
+	result, err := store.store.MoveCollectionTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) ForkSnippetTx(ctx context.Context, arg ForkSnippetTxParams) (ForkSnippetTxResult, error) {
+	ctx, done := store.observe(ctx, "ForkSnippetTx")
+	result, err := store.store.ForkSnippetTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetTx(ctx context.Context, arg CreateSnippetTxParams) (CreateSnippetTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetTx")
+	result, err := store.store.CreateSnippetTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) UpdateSnippetTx(ctx context.Context, arg UpdateSnippetTxParams) (UpdateSnippetTxResult, error) {
+	ctx, done := store.observe(ctx, "UpdateSnippetTx")
+	result, err := store.store.UpdateSnippetTx(ctx, arg)
+	done(err)
+	return result, err
+}
+
+func (store *ObservedStore) CreateSnippetCommentTx(ctx context.Context, arg CreateSnippetCommentTxParams) (CreateSnippetCommentTxResult, error) {
+	ctx, done := store.observe(ctx, "CreateSnippetCommentTx")
+	result, err := store.store.CreateSnippetCommentTx(ctx, arg)
+	done(err)
+	return result, err
+}
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomOrganization(t *testing.T) (Organization, OrganizationMember) {
+	owner := createRandomUser(t)
+	arg := CreateOrganizationParams{
+		Name:        util.RandomUser(),
+		DisplayName: util.RandomUser(),
+	}
+
+	organization, err := testQueries.CreateOrganization(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, organization.Name)
+	require.Equal(t, arg.DisplayName, organization.DisplayName)
+	require.NotZero(t, organization.Created)
+
+	member, err := testQueries.CreateOrganizationMember(context.Background(), CreateOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       owner.Name,
+		Role:           util.OrgRoleOwner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OrgRoleOwner, member.Role)
+
+	return organization, member
+}
+
+func TestListOrganizationsByMember(t *testing.T) {
+	organization, owner := createRandomOrganization(t)
+
+	organizations, err := testQueries.ListOrganizationsByMember(context.Background(), owner.Username)
+	require.NoError(t, err)
+	require.Len(t, organizations, 1)
+	require.Equal(t, organization.ID, organizations[0].ID)
+	require.Equal(t, util.OrgRoleOwner, organizations[0].Role)
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	organization, _ := createRandomOrganization(t)
+	user := createRandomUser(t)
+
+	member, err := testQueries.CreateOrganizationMember(context.Background(), CreateOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+		Role:           util.OrgRoleViewer,
+	})
+	require.NoError(t, err)
+
+	member, err = testQueries.UpdateOrganizationMemberRole(context.Background(), UpdateOrganizationMemberRoleParams{
+		Role:           util.OrgRoleOwner,
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OrgRoleOwner, member.Role)
+
+	owners, err := testQueries.LockOrganizationOwners(context.Background(), organization.ID)
+	require.NoError(t, err)
+	require.Len(t, owners, 2)
+
+	members, err := testQueries.ListOrganizationMembers(context.Background(), organization.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+
+	err = testQueries.DeleteOrganizationMember(context.Background(), DeleteOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.GetOrganizationMember(context.Background(), GetOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestLastOrganizationOwner(t *testing.T) {
+	organization, owner := createRandomOrganization(t)
+	user := createRandomUser(t)
+
+	_, err := testQueries.CreateOrganizationMember(context.Background(), CreateOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+		Role:           util.OrgRoleOwner,
+	})
+	require.NoError(t, err)
+
+	// with a second owner the first one can step down
+	member, err := testStore.UpdateOrganizationMemberRoleTx(context.Background(), UpdateOrganizationMemberRoleParams{
+		Role:           util.OrgRoleMember,
+		OrganizationID: organization.ID,
+		Username:       owner.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OrgRoleMember, member.Role)
+
+	_, err = testStore.UpdateOrganizationMemberRoleTx(context.Background(), UpdateOrganizationMemberRoleParams{
+		Role:           util.OrgRoleMaintainer,
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+	})
+	require.ErrorIs(t, err, ErrLastOrganizationOwner)
+
+	err = testStore.RemoveOrganizationMemberTx(context.Background(), DeleteOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       user.Name,
+	})
+	require.ErrorIs(t, err, ErrLastOrganizationOwner)
+
+	// members below owner are removed freely
+	err = testStore.RemoveOrganizationMemberTx(context.Background(), DeleteOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		Username:       owner.Username,
+	})
+	require.NoError(t, err)
+}
+
+func TestOrganizationInvitation(t *testing.T) {
+	organization, owner := createRandomOrganization(t)
+	invitee := createRandomUser(t)
+
+	arg := CreateOrganizationInvitationParams{
+		OrganizationID: organization.ID,
+		Invitee:        invitee.Name,
+		Role:           util.OrgRoleMember,
+		InvitedBy:      owner.Username,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	invitation, err := testQueries.CreateOrganizationInvitation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.InvitationStatusPending, invitation.Status)
+
+	// only one pending invitation per user
+	_, err = testQueries.CreateOrganizationInvitation(context.Background(), arg)
+	require.Error(t, err)
+
+	invitations, err := testQueries.ListPendingInvitationsByInvitee(context.Background(), invitee.Name)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+
+	invitation, err = testQueries.RespondOrganizationInvitation(context.Background(), RespondOrganizationInvitationParams{
+		Status: util.InvitationStatusDeclined,
+		ID:     invitation.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.InvitationStatusDeclined, invitation.Status)
+	require.True(t, invitation.RespondedAt.Valid)
+
+	// a responded invitation cannot be answered again
+	_, err = testQueries.RespondOrganizationInvitation(context.Background(), RespondOrganizationInvitationParams{
+		Status: util.InvitationStatusAccepted,
+		ID:     invitation.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestTransferAccount(t *testing.T) {
+	account := createRandomAccount(t)
+	organization, _ := createRandomOrganization(t)
+
+	transferred, err := testQueries.TransferAccount(context.Background(), TransferAccountParams{
+		Login:          account.Login,
+		OrganizationID: sql.NullInt64{Int64: organization.ID, Valid: true},
+		ID:             account.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, organization.ID, transferred.OrganizationID.Int64)
+
+	accounts, err := testQueries.ListAccountsByOrganization(context.Background(), transferred.OrganizationID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	personal, err := testQueries.ListAccountsByLogin(context.Background(), account.Login)
+	require.NoError(t, err)
+	require.Empty(t, personal)
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomOutboxTask(t *testing.T) Outbox {
+	arg := CreateOutboxTaskParams{
+		TaskType: util.RandomString(10),
+		Payload:  json.RawMessage(`{"name":"` + util.RandomUser() + `"}`),
+		Queue:    "critical",
+		MaxRetry: 10,
+		ProcessAt: sql.NullTime{
+			Time:  time.Now().Add(10 * time.Second),
+			Valid: true,
+		},
+	}
+
+	task, err := testQueries.CreateOutboxTask(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, task)
+
+	require.Equal(t, arg.TaskType, task.TaskType)
+	require.JSONEq(t, string(arg.Payload), string(task.Payload))
+	require.Equal(t, arg.Queue, task.Queue)
+	require.Equal(t, arg.MaxRetry, task.MaxRetry)
+	require.WithinDuration(t, arg.ProcessAt.Time, task.ProcessAt.Time, time.Second)
+	require.Zero(t, task.Attempts)
+	require.Equal(t, int32(20), task.MaxAttempts)
+	require.False(t, task.DeadAt.Valid)
+	require.False(t, task.PublishedAt.Valid)
+	require.NotZero(t, task.Created)
+
+	return task
+}
+
+func TestCreateOutboxTask(t *testing.T) {
+	createRandomOutboxTask(t)
+}
+
+func TestListPendingOutboxTasks(t *testing.T) {
+	task := createRandomOutboxTask(t)
+
+	tasks, err := testQueries.ListPendingOutboxTasks(context.Background(), 1000)
+	require.NoError(t, err)
+	require.NotEmpty(t, tasks)
+
+	for _, pending := range tasks {
+		require.False(t, pending.PublishedAt.Valid)
+	}
+	require.Contains(t, tasks, task)
+}
+
+func TestMarkOutboxTaskFailed(t *testing.T) {
+	task := createRandomOutboxTask(t)
+	nextAttemptAt := time.Now().Add(time.Minute)
+
+	failed, err := testQueries.MarkOutboxTaskFailed(context.Background(), MarkOutboxTaskFailedParams{
+		ID: task.ID,
+		LastError: sql.NullString{
+			String: "redis is down",
+			Valid:  true,
+		},
+		NextAttemptAt: nextAttemptAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), failed.Attempts)
+	require.Equal(t, "redis is down", failed.LastError.String)
+	require.WithinDuration(t, nextAttemptAt, failed.NextAttemptAt, time.Second)
+	require.False(t, failed.DeadAt.Valid)
+
+	// the row isn't due before the backoff has passed
+	tasks, err := testQueries.ListPendingOutboxTasks(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, pending := range tasks {
+		require.NotEqual(t, task.ID, pending.ID)
+	}
+}
+
+func TestMarkOutboxTaskDead(t *testing.T) {
+	task := createRandomOutboxTask(t)
+	failed := failOutboxTask(t, task, task.MaxAttempts)
+
+	require.Equal(t, task.MaxAttempts, failed.Attempts)
+	require.True(t, failed.DeadAt.Valid)
+
+	tasks, err := testQueries.ListPendingOutboxTasks(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, pending := range tasks {
+		require.NotEqual(t, task.ID, pending.ID)
+	}
+}
+
+// failOutboxTask records n failed attempts that leave the row due right away
+func failOutboxTask(t *testing.T, task Outbox, n int32) Outbox {
+	for i := int32(0); i < n; i++ {
+		var err error
+		task, err = testQueries.MarkOutboxTaskFailed(context.Background(), MarkOutboxTaskFailedParams{
+			ID: task.ID,
+			LastError: sql.NullString{
+				String: "redis is down",
+				Valid:  true,
+			},
+			NextAttemptAt: time.Now().Add(-time.Second),
+		})
+		require.NoError(t, err)
+	}
+	return task
+}
+
+func TestPublishOutboxTx(t *testing.T) {
+	published := createRandomOutboxTask(t)
+	failing := createRandomOutboxTask(t)
+	dying := createRandomOutboxTask(t)
+	dying = failOutboxTask(t, dying, dying.MaxAttempts-1)
+
+	var publishedIDs []int64
+	result, err := testStore.PublishOutboxTx(context.Background(), PublishOutboxTxParams{
+		Limit: 1000,
+		Publish: func(task Outbox) error {
+			if task.ID == failing.ID || task.ID == dying.ID {
+				return errors.New("redis is down")
+			}
+			publishedIDs = append(publishedIDs, task.ID)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, publishedIDs, published.ID)
+	require.Equal(t, len(publishedIDs), result.Published)
+	require.Equal(t, 2, result.Failed)
+	require.Equal(t, 1, result.Dead)
+
+	// neither the published row nor the failed ones are due again
+	tasks, err := testQueries.ListPendingOutboxTasks(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, pending := range tasks {
+		require.NotEqual(t, published.ID, pending.ID)
+		require.NotEqual(t, failing.ID, pending.ID)
+		require.NotEqual(t, dying.ID, pending.ID)
+	}
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	require.Equal(t, 5*time.Second, outboxRetryDelay(0))
+	require.Equal(t, 10*time.Second, outboxRetryDelay(1))
+	require.Equal(t, 40*time.Second, outboxRetryDelay(3))
+	require.Equal(t, time.Hour, outboxRetryDelay(20))
+}
+
+func TestMarkOutboxTaskPublished(t *testing.T) {
+	task := createRandomOutboxTask(t)
+
+	err := testQueries.MarkOutboxTaskPublished(context.Background(), task.ID)
+	require.NoError(t, err)
+
+	tasks, err := testQueries.ListPendingOutboxTasks(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, pending := range tasks {
+		require.NotEqual(t, task.ID, pending.ID)
+	}
+
+	deleted, err := testQueries.DeletePublishedOutboxTasks(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+}
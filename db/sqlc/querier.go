@@ -6,24 +6,108 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddCollectionSnippet(ctx context.Context, arg AddCollectionSnippetParams) (CollectionSnippet, error)
+	BlockSession(ctx context.Context, id uuid.UUID) (Session, error)
+	ClearStaleSnippetCommentAnchors(ctx context.Context, snippetID int32) error
+	CopySnippetFiles(ctx context.Context, arg CopySnippetFilesParams) error
+	CountSnippetForks(ctx context.Context, forkedFrom sql.NullInt32) (int64, error)
+	CountSnippetStars(ctx context.Context, snippetID int32) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	CreateCollection(ctx context.Context, arg CreateCollectionParams) (Collection, error)
+	CreateExport(ctx context.Context, owner string) (Export, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitation, error)
+	CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) (OrganizationMember, error)
+	CreateOutboxTask(ctx context.Context, arg CreateOutboxTaskParams) (Outbox, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSnippet(ctx context.Context, arg CreateSnippetParams) (Snippet, error)
+	CreateSnippetComment(ctx context.Context, arg CreateSnippetCommentParams) (SnippetComment, error)
+	CreateSnippetFork(ctx context.Context, arg CreateSnippetForkParams) (Snippet, error)
+	CreateSnippetImport(ctx context.Context, arg CreateSnippetImportParams) (SnippetImport, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
 	DeleteAccount(ctx context.Context, id int32) error
-	DeleteSnippet(ctx context.Context, id int32) error
+	DeleteCollection(ctx context.Context, id int64) error
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	DeleteOtherSnippetFiles(ctx context.Context, arg DeleteOtherSnippetFilesParams) error
+	DeletePublishedOutboxTasks(ctx context.Context, before time.Time) (int64, error)
+	DeleteSnippet(ctx context.Context, id int32) (Snippet, error)
+	DeleteSnippetComment(ctx context.Context, arg DeleteSnippetCommentParams) (SnippetComment, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	ExpireExport(ctx context.Context, id int64) error
+	ExportAuditEvents(ctx context.Context, arg ExportAuditEventsParams) ([]AuditEvent, error)
+	FinishExport(ctx context.Context, arg FinishExportParams) (Export, error)
+	FinishSnippetImport(ctx context.Context, arg FinishSnippetImportParams) (SnippetImport, error)
 	GetAccount(ctx context.Context, id int32) (Account, error)
+	GetCollection(ctx context.Context, id int64) (Collection, error)
+	GetCollectionDepth(ctx context.Context, arg GetCollectionDepthParams) (int32, error)
+	GetExport(ctx context.Context, id int64) (Export, error)
+	GetOrganization(ctx context.Context, id int64) (Organization, error)
+	GetOrganizationInvitation(ctx context.Context, id int64) (OrganizationInvitation, error)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetSnippet(ctx context.Context, id int32) (Snippet, error)
+	GetSnippetByTitle(ctx context.Context, arg GetSnippetByTitleParams) (Snippet, error)
+	GetSnippetComment(ctx context.Context, id int64) (SnippetComment, error)
+	GetSnippetFile(ctx context.Context, arg GetSnippetFileParams) (SnippetFile, error)
+	GetSnippetImport(ctx context.Context, id int64) (SnippetImport, error)
 	GetUser(ctx context.Context, name string) (User, error)
+	GetWebhook(ctx context.Context, id int64) (Webhook, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	IsSnippetStarred(ctx context.Context, arg IsSnippetStarredParams) (bool, error)
+	ListAccountsByLogin(ctx context.Context, login string) ([]Account, error)
+	ListAccountsByOrganization(ctx context.Context, organizationID sql.NullInt64) ([]Account, error)
+	ListActiveWebhooksForEvent(ctx context.Context, arg ListActiveWebhooksForEventParams) ([]Webhook, error)
+	ListCollectionSnippetIDs(ctx context.Context, collectionID int64) ([]int32, error)
+	ListCollectionSubtree(ctx context.Context, arg ListCollectionSubtreeParams) ([]ListCollectionSubtreeRow, error)
+	ListCollections(ctx context.Context, accountID int32) ([]Collection, error)
+	ListExpiredExports(ctx context.Context, arg ListExpiredExportsParams) ([]Export, error)
+	ListOrganizationMembers(ctx context.Context, organizationID int64) ([]OrganizationMember, error)
+	ListOrganizationsByMember(ctx context.Context, username string) ([]ListOrganizationsByMemberRow, error)
+	ListPendingInvitationsByInvitee(ctx context.Context, invitee string) ([]OrganizationInvitation, error)
+	ListPendingOutboxTasks(ctx context.Context, limit int32) ([]Outbox, error)
+	ListSessionsByName(ctx context.Context, name string) ([]Session, error)
+	ListSnippetComments(ctx context.Context, arg ListSnippetCommentsParams) ([]SnippetComment, error)
+	ListSnippetFiles(ctx context.Context, snippetID int32) ([]SnippetFile, error)
+	ListSnippetForks(ctx context.Context, arg ListSnippetForksParams) ([]Snippet, error)
 	ListSnippets(ctx context.Context, arg ListSnippetsParams) ([]Snippet, error)
+	ListSnippetsByCollection(ctx context.Context, arg ListSnippetsByCollectionParams) ([]Snippet, error)
+	ListStarredSnippets(ctx context.Context, arg ListStarredSnippetsParams) ([]Snippet, error)
+	ListUserAuditEvents(ctx context.Context, arg ListUserAuditEventsParams) ([]AuditEvent, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, owner string) ([]Webhook, error)
+	LockAccountCollections(ctx context.Context, accountID int32) error
+	LockOrganizationOwners(ctx context.Context, organizationID int64) ([]string, error)
+	MarkOutboxTaskFailed(ctx context.Context, arg MarkOutboxTaskFailedParams) (Outbox, error)
+	MarkOutboxTaskPublished(ctx context.Context, id int64) error
+	MoveCollection(ctx context.Context, arg MoveCollectionParams) (Collection, error)
+	RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (Webhook, error)
+	RemoveCollectionSnippet(ctx context.Context, arg RemoveCollectionSnippetParams) (CollectionSnippet, error)
+	RenameCollection(ctx context.Context, arg RenameCollectionParams) (Collection, error)
+	ResetWebhookFailures(ctx context.Context, id int64) error
+	RespondOrganizationInvitation(ctx context.Context, arg RespondOrganizationInvitationParams) (OrganizationInvitation, error)
+	SearchAuditEvents(ctx context.Context, arg SearchAuditEventsParams) ([]AuditEvent, error)
+	StarSnippet(ctx context.Context, arg StarSnippetParams) error
+	StartSnippetImport(ctx context.Context, id int64) (SnippetImport, error)
+	TransferAccount(ctx context.Context, arg TransferAccountParams) (Account, error)
+	UnstarSnippet(ctx context.Context, arg UnstarSnippetParams) error
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateCollectionSnippetPosition(ctx context.Context, arg UpdateCollectionSnippetPositionParams) error
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMember, error)
+	UpdateSnippet(ctx context.Context, arg UpdateSnippetParams) (Snippet, error)
+	UpdateSnippetComment(ctx context.Context, arg UpdateSnippetCommentParams) (SnippetComment, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error)
+	UpsertSnippetFile(ctx context.Context, arg UpsertSnippetFileParams) (SnippetFile, error)
 }
 
 var _ Querier = (*Queries)(nil)
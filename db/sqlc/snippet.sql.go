@@ -7,50 +7,122 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const countSnippetForks = `-- name: CountSnippetForks :one
+SELECT count(*) FROM snippets
+WHERE forked_from = $1
+`
+
+func (q *Queries) CountSnippetForks(ctx context.Context, forkedFrom sql.NullInt32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSnippetForks, forkedFrom)
+	var i int64
+	err := row.Scan(&i)
+	return i, err
+}
+
 const createSnippet = `-- name: CreateSnippet :one
 INSERT INTO snippets (
   account_id,
   title,
-  content
+  language,
+  is_public
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 )
-RETURNING id, account_id, title, content, created
+RETURNING id, account_id, title, created, language, is_public, forked_from
 `
 
 type CreateSnippetParams struct {
 	AccountID int32  `json:"account_id"`
 	Title     string `json:"title"`
-	Content   string `json:"content"`
+	Language  string `json:"language"`
+	IsPublic  bool   `json:"is_public"`
 }
 
 func (q *Queries) CreateSnippet(ctx context.Context, arg CreateSnippetParams) (Snippet, error) {
-	row := q.db.QueryRowContext(ctx, createSnippet, arg.AccountID, arg.Title, arg.Content)
+	row := q.db.QueryRowContext(ctx, createSnippet,
+		arg.AccountID,
+		arg.Title,
+		arg.Language,
+		arg.IsPublic,
+	)
 	var i Snippet
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Title,
-		&i.Content,
 		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
 	)
 	return i, err
 }
 
-const deleteSnippet = `-- name: DeleteSnippet :exec
+const createSnippetFork = `-- name: CreateSnippetFork :one
+INSERT INTO snippets (
+  account_id,
+  title,
+  language,
+  forked_from
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, account_id, title, created, language, is_public, forked_from
+`
+
+type CreateSnippetForkParams struct {
+	AccountID  int32         `json:"account_id"`
+	Title      string        `json:"title"`
+	Language   string        `json:"language"`
+	ForkedFrom sql.NullInt32 `json:"forked_from"`
+}
+
+func (q *Queries) CreateSnippetFork(ctx context.Context, arg CreateSnippetForkParams) (Snippet, error) {
+	row := q.db.QueryRowContext(ctx, createSnippetFork,
+		arg.AccountID,
+		arg.Title,
+		arg.Language,
+		arg.ForkedFrom,
+	)
+	var i Snippet
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Title,
+		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
+	)
+	return i, err
+}
+
+const deleteSnippet = `-- name: DeleteSnippet :one
 DELETE FROM snippets
 WHERE id = $1
+RETURNING id, account_id, title, created, language, is_public, forked_from
 `
 
-func (q *Queries) DeleteSnippet(ctx context.Context, id int32) error {
-	_, err := q.db.ExecContext(ctx, deleteSnippet, id)
-	return err
+func (q *Queries) DeleteSnippet(ctx context.Context, id int32) (Snippet, error) {
+	row := q.db.QueryRowContext(ctx, deleteSnippet, id)
+	var i Snippet
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Title,
+		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
+	)
+	return i, err
 }
 
 const getSnippet = `-- name: GetSnippet :one
-SELECT id, account_id, title, content, created FROM snippets
+SELECT id, account_id, title, created, language, is_public, forked_from FROM snippets
 WHERE id = $1 LIMIT 1
 `
 
@@ -61,14 +133,102 @@ func (q *Queries) GetSnippet(ctx context.Context, id int32) (Snippet, error) {
 		&i.ID,
 		&i.AccountID,
 		&i.Title,
-		&i.Content,
 		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
+	)
+	return i, err
+}
+
+const getSnippetByTitle = `-- name: GetSnippetByTitle :one
+SELECT id, account_id, title, created, language, is_public, forked_from FROM snippets
+WHERE account_id = $1 AND title = $2
+ORDER BY id
+LIMIT 1
+`
+
+type GetSnippetByTitleParams struct {
+	AccountID int32  `json:"account_id"`
+	Title     string `json:"title"`
+}
+
+func (q *Queries) GetSnippetByTitle(ctx context.Context, arg GetSnippetByTitleParams) (Snippet, error) {
+	row := q.db.QueryRowContext(ctx, getSnippetByTitle, arg.AccountID, arg.Title)
+	var i Snippet
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Title,
+		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
 	)
 	return i, err
 }
 
+const listSnippetForks = `-- name: ListSnippetForks :many
+SELECT s.id, s.account_id, s.title, s.created, s.language, s.is_public, s.forked_from FROM snippets s
+JOIN account a ON a.id = s.account_id
+WHERE s.forked_from = $1 AND (
+  s.is_public
+  OR (a.organization_id IS NULL AND a.login = $2)
+  OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.organization_id = a.organization_id AND m.username = $2
+  )
+)
+ORDER BY s.id
+LIMIT $3
+OFFSET $4
+`
+
+type ListSnippetForksParams struct {
+	ForkedFrom  sql.NullInt32 `json:"forked_from"`
+	Username    string        `json:"username"`
+	LimitCount  int32         `json:"limit_count"`
+	OffsetCount int32         `json:"offset_count"`
+}
+
+func (q *Queries) ListSnippetForks(ctx context.Context, arg ListSnippetForksParams) ([]Snippet, error) {
+	rows, err := q.db.QueryContext(ctx, listSnippetForks,
+		arg.ForkedFrom,
+		arg.Username,
+		arg.LimitCount,
+		arg.OffsetCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Snippet{}
+	for rows.Next() {
+		var i Snippet
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Title,
+			&i.Created,
+			&i.Language,
+			&i.IsPublic,
+			&i.ForkedFrom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSnippets = `-- name: ListSnippets :many
-SELECT id, account_id, title, content, created FROM snippets
+SELECT id, account_id, title, created, language, is_public, forked_from FROM snippets
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
@@ -94,8 +254,10 @@ func (q *Queries) ListSnippets(ctx context.Context, arg ListSnippetsParams) ([]S
 			&i.ID,
 			&i.AccountID,
 			&i.Title,
-			&i.Content,
 			&i.Created,
+			&i.Language,
+			&i.IsPublic,
+			&i.ForkedFrom,
 		); err != nil {
 			return nil, err
 		}
@@ -109,3 +271,40 @@ func (q *Queries) ListSnippets(ctx context.Context, arg ListSnippetsParams) ([]S
 	}
 	return items, nil
 }
+
+const updateSnippet = `-- name: UpdateSnippet :one
+UPDATE snippets
+SET
+  title = COALESCE($1, title),
+  language = COALESCE($2, language),
+  is_public = COALESCE($3, is_public)
+WHERE id = $4
+RETURNING id, account_id, title, created, language, is_public, forked_from
+`
+
+type UpdateSnippetParams struct {
+	Title    sql.NullString `json:"title"`
+	Language sql.NullString `json:"language"`
+	IsPublic sql.NullBool   `json:"is_public"`
+	ID       int32          `json:"id"`
+}
+
+func (q *Queries) UpdateSnippet(ctx context.Context, arg UpdateSnippetParams) (Snippet, error) {
+	row := q.db.QueryRowContext(ctx, updateSnippet,
+		arg.Title,
+		arg.Language,
+		arg.IsPublic,
+		arg.ID,
+	)
+	var i Snippet
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Title,
+		&i.Created,
+		&i.Language,
+		&i.IsPublic,
+		&i.ForkedFrom,
+	)
+	return i, err
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSnippetComment(t *testing.T, snippet Snippet, author string, parentID sql.NullInt64) SnippetComment {
+	arg := CreateSnippetCommentParams{
+		SnippetID: snippet.ID,
+		ParentID:  parentID,
+		Author:    author,
+		Body:      util.RandomString(20),
+	}
+
+	comment, err := testQueries.CreateSnippetComment(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, comment.ID)
+	require.Equal(t, arg.SnippetID, comment.SnippetID)
+	require.Equal(t, arg.ParentID, comment.ParentID)
+	require.Equal(t, arg.Author, comment.Author)
+	require.Equal(t, arg.Body, comment.Body)
+	require.False(t, comment.EditedAt.Valid)
+	require.False(t, comment.DeletedAt.Valid)
+
+	return comment
+}
+
+func TestListSnippetCommentsByThread(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+
+	first := createRandomSnippetComment(t, snippet, account.Login, sql.NullInt64{})
+	second := createRandomSnippetComment(t, snippet, account.Login, sql.NullInt64{})
+	reply := createRandomSnippetComment(t, snippet, account.Login, sql.NullInt64{Int64: first.ID, Valid: true})
+
+	// replies follow their comment even when written later
+	comments, err := testQueries.ListSnippetComments(context.Background(), ListSnippetCommentsParams{
+		SnippetID: snippet.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, comments, 3)
+	require.Equal(t, first.ID, comments[0].ID)
+	require.Equal(t, reply.ID, comments[1].ID)
+	require.Equal(t, second.ID, comments[2].ID)
+}
+
+func TestSnippetCommentLineRange(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+
+	_, err := testQueries.CreateSnippetComment(context.Background(), CreateSnippetCommentParams{
+		SnippetID: snippet.ID,
+		Author:    account.Login,
+		Body:      util.RandomString(20),
+		LineStart: sql.NullInt32{Int32: 3, Valid: true},
+		LineEnd:   sql.NullInt32{Int32: 2, Valid: true},
+	})
+	require.Error(t, err)
+}
+
+func TestUpdateAndDeleteSnippetComment(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+	comment := createRandomSnippetComment(t, snippet, account.Login, sql.NullInt64{})
+
+	body := util.RandomString(20)
+	updated, err := testQueries.UpdateSnippetComment(context.Background(), UpdateSnippetCommentParams{
+		ID:   comment.ID,
+		Body: body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, body, updated.Body)
+	require.True(t, updated.EditedAt.Valid)
+
+	deleted, err := testQueries.DeleteSnippetComment(context.Background(), DeleteSnippetCommentParams{
+		ID:        comment.ID,
+		DeletedBy: sql.NullString{String: account.Login, Valid: true},
+	})
+	require.NoError(t, err)
+	require.True(t, deleted.DeletedAt.Valid)
+	require.Equal(t, account.Login, deleted.DeletedBy.String)
+
+	// deleted comments can be neither edited nor deleted again
+	_, err = testQueries.UpdateSnippetComment(context.Background(), UpdateSnippetCommentParams{
+		ID:   comment.ID,
+		Body: body,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.DeleteSnippetComment(context.Background(), DeleteSnippetCommentParams{
+		ID:        comment.ID,
+		DeletedBy: sql.NullString{String: account.Login, Valid: true},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomSnippetFiles(n int) []SnippetFileParams {
+	files := make([]SnippetFileParams, n)
+	for i := range files {
+		files[i] = SnippetFileParams{
+			Filename: util.RandomString(8) + ".go",
+			Language: "go",
+			Content:  util.RandomContent(),
+		}
+	}
+	return files
+}
+
+func TestSaveSnippetFiles(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+
+	arg := randomSnippetFiles(3)
+	files, err := saveSnippetFiles(context.Background(), testQueries, snippet.ID, arg)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	for i, file := range files {
+		require.Equal(t, snippet.ID, file.SnippetID)
+		require.Equal(t, arg[i].Filename, file.Filename)
+		require.Equal(t, arg[i].Content, file.Content)
+		require.Equal(t, int32(i+1), file.Position)
+	}
+
+	// keep the last file first with a new content, add one and drop the others
+	kept := arg[2]
+	kept.Content = util.RandomContent()
+	added := randomSnippetFiles(1)[0]
+
+	updated, err := saveSnippetFiles(context.Background(), testQueries, snippet.ID, []SnippetFileParams{kept, added})
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+	require.Equal(t, files[2].ID, updated[0].ID)
+	require.Equal(t, kept.Content, updated[0].Content)
+	require.Equal(t, int32(1), updated[0].Position)
+
+	listed, err := testQueries.ListSnippetFiles(context.Background(), snippet.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, listed)
+
+	_, err = testQueries.GetSnippetFile(context.Background(), GetSnippetFileParams{
+		SnippetID: snippet.ID,
+		Filename:  arg[0].Filename,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCopySnippetFiles(t *testing.T) {
+	account := createRandomAccount(t)
+	original := createRandomSnippet(t, account)
+	fork := createRandomSnippet(t, account)
+
+	files, err := saveSnippetFiles(context.Background(), testQueries, original.ID, randomSnippetFiles(2))
+	require.NoError(t, err)
+
+	err = testQueries.CopySnippetFiles(context.Background(), CopySnippetFilesParams{
+		ToSnippetID:   fork.ID,
+		FromSnippetID: original.ID,
+	})
+	require.NoError(t, err)
+
+	copied, err := testQueries.ListSnippetFiles(context.Background(), fork.ID)
+	require.NoError(t, err)
+	require.Len(t, copied, len(files))
+	for i, file := range copied {
+		require.Equal(t, fork.ID, file.SnippetID)
+		require.Equal(t, files[i].Filename, file.Filename)
+		require.Equal(t, files[i].Content, file.Content)
+		require.Equal(t, files[i].Position, file.Position)
+	}
+}
+
+func TestUpdateSnippetTxClearsStaleAnchors(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+
+	files := []SnippetFileParams{
+		{Filename: "kept.go", Language: "go", Content: "one\ntwo\nthree\n"},
+		{Filename: "removed.go", Language: "go", Content: "one\n"},
+	}
+	_, err := saveSnippetFiles(context.Background(), testQueries, snippet.ID, files)
+	require.NoError(t, err)
+
+	anchored := func(filename string, lineStart, lineEnd int32) SnippetComment {
+		comment, err := testQueries.CreateSnippetComment(context.Background(), CreateSnippetCommentParams{
+			SnippetID: snippet.ID,
+			Author:    account.Login,
+			Body:      util.RandomString(20),
+			LineStart: sql.NullInt32{Int32: lineStart, Valid: true},
+			LineEnd:   sql.NullInt32{Int32: lineEnd, Valid: true},
+			Filename:  sql.NullString{String: filename, Valid: true},
+		})
+		require.NoError(t, err)
+		return comment
+	}
+	inRange := anchored("kept.go", 1, 2)
+	pastEnd := anchored("kept.go", 2, 3)
+	onRemoved := anchored("removed.go", 1, 1)
+
+	// the kept file shrinks to two lines and the other file goes away
+	_, err = testStore.UpdateSnippetTx(context.Background(), UpdateSnippetTxParams{
+		UpdateSnippetParams: UpdateSnippetParams{ID: snippet.ID},
+		Files: []SnippetFileParams{
+			{Filename: "kept.go", Language: "go", Content: "one\ntwo\n"},
+		},
+	})
+	require.NoError(t, err)
+
+	comment, err := testQueries.GetSnippetComment(context.Background(), inRange.ID)
+	require.NoError(t, err)
+	require.Equal(t, inRange.Filename, comment.Filename)
+	require.Equal(t, inRange.LineEnd, comment.LineEnd)
+
+	for _, stale := range []SnippetComment{pastEnd, onRemoved} {
+		comment, err := testQueries.GetSnippetComment(context.Background(), stale.ID)
+		require.NoError(t, err)
+		require.False(t, comment.Filename.Valid)
+		require.False(t, comment.LineStart.Valid)
+		require.False(t, comment.LineEnd.Valid)
+	}
+}
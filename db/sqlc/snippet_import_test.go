@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSnippetImport(t *testing.T) SnippetImport {
+	user := createRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Login:    user.Name,
+		Username: util.RandomUser(),
+	})
+	require.NoError(t, err)
+
+	arg := CreateSnippetImportParams{
+		Owner:           user.Name,
+		AccountID:       account.ID,
+		Format:          util.ImportFormatJSONL,
+		DuplicatePolicy: util.DuplicatePolicySkip,
+		Archive:         []byte(`{"title": "hello", "content": "world"}`),
+	}
+
+	snippetImport, err := testQueries.CreateSnippetImport(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, snippetImport)
+
+	require.Equal(t, arg.Owner, snippetImport.Owner)
+	require.Equal(t, arg.AccountID, snippetImport.AccountID)
+	require.Equal(t, arg.Format, snippetImport.Format)
+	require.Equal(t, arg.DuplicatePolicy, snippetImport.DuplicatePolicy)
+	require.Equal(t, arg.Archive, snippetImport.Archive)
+	require.Equal(t, util.ImportStatusPending, snippetImport.Status)
+	require.JSONEq(t, `[]`, string(snippetImport.Errors))
+	require.False(t, snippetImport.FinishedAt.Valid)
+
+	return snippetImport
+}
+
+func TestCreateSnippetImport(t *testing.T) {
+	createRandomSnippetImport(t)
+}
+
+func TestFinishSnippetImport(t *testing.T) {
+	snippetImport := createRandomSnippetImport(t)
+
+	started, err := testQueries.StartSnippetImport(context.Background(), snippetImport.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ImportStatusRunning, started.Status)
+
+	arg := FinishSnippetImportParams{
+		Status:       util.ImportStatusCompleted,
+		Total:        3,
+		CreatedCount: 1,
+		UpdatedCount: 0,
+		SkippedCount: 1,
+		FailedCount:  1,
+		Errors:       json.RawMessage(`[{"name": "line 3", "error": "invalid json"}]`),
+		ID:           snippetImport.ID,
+	}
+
+	finished, err := testQueries.FinishSnippetImport(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Status, finished.Status)
+	require.Equal(t, arg.Total, finished.Total)
+	require.Equal(t, arg.CreatedCount, finished.CreatedCount)
+	require.Equal(t, arg.SkippedCount, finished.SkippedCount)
+	require.Equal(t, arg.FailedCount, finished.FailedCount)
+	require.JSONEq(t, string(arg.Errors), string(finished.Errors))
+	require.Nil(t, finished.Archive)
+	require.True(t, finished.FinishedAt.Valid)
+
+	got, err := testQueries.GetSnippetImport(context.Background(), snippetImport.ID)
+	require.NoError(t, err)
+	require.Equal(t, finished.Status, got.Status)
+}
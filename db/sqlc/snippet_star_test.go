@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnippetStars(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet := createRandomSnippet(t, account)
+
+	// starring twice keeps one star
+	for i := 0; i < 2; i++ {
+		err := testQueries.StarSnippet(context.Background(), StarSnippetParams{
+			Username:  account.Login,
+			SnippetID: snippet.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := testQueries.CountSnippetStars(context.Background(), snippet.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	starred, err := testQueries.IsSnippetStarred(context.Background(), IsSnippetStarredParams{
+		Username:  account.Login,
+		SnippetID: snippet.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, starred)
+
+	snippets, err := testQueries.ListStarredSnippets(context.Background(), ListStarredSnippetsParams{
+		Username:   account.Login,
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, snippets, 1)
+	require.Equal(t, snippet.ID, snippets[0].ID)
+
+	err = testQueries.UnstarSnippet(context.Background(), UnstarSnippetParams{
+		Username:  account.Login,
+		SnippetID: snippet.ID,
+	})
+	require.NoError(t, err)
+
+	count, err = testQueries.CountSnippetStars(context.Background(), snippet.ID)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestListStarredSnippetsHidesPrivate(t *testing.T) {
+	owner := createRandomAccount(t)
+	other := createRandomAccount(t)
+	snippet := createRandomSnippet(t, owner)
+
+	err := testQueries.StarSnippet(context.Background(), StarSnippetParams{
+		Username:  other.Login,
+		SnippetID: snippet.ID,
+	})
+	require.NoError(t, err)
+
+	snippets, err := testQueries.ListStarredSnippets(context.Background(), ListStarredSnippetsParams{
+		Username:   other.Login,
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, snippets)
+
+	_, err = testQueries.UpdateSnippet(context.Background(), UpdateSnippetParams{
+		IsPublic: sql.NullBool{Bool: true, Valid: true},
+		ID:       snippet.ID,
+	})
+	require.NoError(t, err)
+
+	snippets, err = testQueries.ListStarredSnippets(context.Background(), ListStarredSnippetsParams{
+		Username:   other.Login,
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, snippets, 1)
+}
+
+func TestSnippetForks(t *testing.T) {
+	account := createRandomAccount(t)
+	original := createRandomSnippet(t, account)
+	forkedFrom := sql.NullInt32{Int32: original.ID, Valid: true}
+
+	fork, err := testQueries.CreateSnippetFork(context.Background(), CreateSnippetForkParams{
+		AccountID:  account.ID,
+		Title:      original.Title,
+		Language:   original.Language,
+		ForkedFrom: forkedFrom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, forkedFrom, fork.ForkedFrom)
+	require.False(t, fork.IsPublic)
+
+	count, err := testQueries.CountSnippetForks(context.Background(), forkedFrom)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	forks, err := testQueries.ListSnippetForks(context.Background(), ListSnippetForksParams{
+		ForkedFrom: forkedFrom,
+		Username:   account.Login,
+		LimitCount: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, forks, 1)
+	require.Equal(t, fork.ID, forks[0].ID)
+
+	// the fork outlives the original
+	_, err = testQueries.DeleteSnippet(context.Background(), original.ID)
+	require.NoError(t, err)
+
+	fork, err = testQueries.GetSnippet(context.Background(), fork.ID)
+	require.NoError(t, err)
+	require.False(t, fork.ForkedFrom.Valid)
+}
@@ -14,7 +14,7 @@ func createRandomSnippet(t *testing.T, account Account) Snippet {
 	arg := CreateSnippetParams{
 		AccountID: account.ID,
 		Title:     util.RandomTitle(),
-		Content:   util.RandomContent(),
+		Language:  "go",
 	}
 
 	snippet, err := testQueries.CreateSnippet(context.Background(), arg)
@@ -23,7 +23,7 @@ func createRandomSnippet(t *testing.T, account Account) Snippet {
 
 	require.Equal(t, arg.AccountID, snippet.AccountID)
 	require.Equal(t, arg.Title, snippet.Title)
-	require.Equal(t, arg.Content, snippet.Content)
+	require.Equal(t, arg.Language, snippet.Language)
 
 	require.NotZero(t, snippet.ID)
 	require.NotZero(t, snippet.Created)
@@ -46,10 +46,27 @@ func TestGetSnippet(t *testing.T) {
 	require.Equal(t, snippet1.ID, snippet2.ID)
 	require.Equal(t, snippet1.AccountID, snippet2.AccountID)
 	require.Equal(t, snippet1.Title, snippet2.Title)
-	require.Equal(t, snippet1.Content, snippet2.Content)
 	require.WithinDuration(t, snippet1.Created, snippet2.Created, time.Second)
 }
 
+func TestGetSnippetByTitle(t *testing.T) {
+	account := createRandomAccount(t)
+	snippet1 := createRandomSnippet(t, account)
+
+	snippet2, err := testQueries.GetSnippetByTitle(context.Background(), GetSnippetByTitleParams{
+		AccountID: account.ID,
+		Title:     snippet1.Title,
+	})
+	require.NoError(t, err)
+	require.Equal(t, snippet1.ID, snippet2.ID)
+
+	_, err = testQueries.GetSnippetByTitle(context.Background(), GetSnippetByTitleParams{
+		AccountID: account.ID,
+		Title:     util.RandomString(12),
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
 func TestListSnippet(t *testing.T) {
 	account := createRandomAccount(t)
 	for i := 0; i < 10; i++ {
@@ -72,12 +89,50 @@ func TestListSnippet(t *testing.T) {
 	}
 }
 
+func TestUpdateSnippetOnlyTitle(t *testing.T) {
+	account := createRandomAccount(t)
+	oldSnippet := createRandomSnippet(t, account)
+
+	newTitle := util.RandomTitle()
+	updatedSnippet, err := testQueries.UpdateSnippet(context.Background(), UpdateSnippetParams{
+		Title: sql.NullString{
+			String: newTitle,
+			Valid:  true,
+		},
+		ID: oldSnippet.ID,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, oldSnippet.ID, updatedSnippet.ID)
+	require.Equal(t, newTitle, updatedSnippet.Title)
+	require.Equal(t, oldSnippet.Language, updatedSnippet.Language)
+	require.Equal(t, oldSnippet.AccountID, updatedSnippet.AccountID)
+}
+
+func TestUpdateSnippetOnlyLanguage(t *testing.T) {
+	account := createRandomAccount(t)
+	oldSnippet := createRandomSnippet(t, account)
+
+	updatedSnippet, err := testQueries.UpdateSnippet(context.Background(), UpdateSnippetParams{
+		Language: sql.NullString{
+			String: "python",
+			Valid:  true,
+		},
+		ID: oldSnippet.ID,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, oldSnippet.Title, updatedSnippet.Title)
+	require.Equal(t, "python", updatedSnippet.Language)
+}
+
 func TestDeleteSnippet(t *testing.T) {
 	user := createRandomAccount(t)
 	snippet1 := createRandomSnippet(t, user)
 
-	err := testQueries.DeleteSnippet(context.Background(), snippet1.ID)
+	deleted, err := testQueries.DeleteSnippet(context.Background(), snippet1.ID)
 	require.NoError(t, err)
+	require.Equal(t, snippet1.ID, deleted.ID)
 
 	snippet2, err := testQueries.GetSnippet(context.Background(), snippet1.ID)
 	require.Error(t, err)
@@ -9,6 +9,19 @@ import (
 type Store interface {
 	Querier
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	CreateSnippetImportTx(ctx context.Context, arg CreateSnippetImportTxParams) (CreateSnippetImportTxResult, error)
+	CreateExportTx(ctx context.Context, arg CreateExportTxParams) (CreateExportTxResult, error)
+	PublishOutboxTx(ctx context.Context, arg PublishOutboxTxParams) (PublishOutboxTxResult, error)
+	CreateOrganizationTx(ctx context.Context, arg CreateOrganizationTxParams) (CreateOrganizationTxResult, error)
+	AcceptOrganizationInvitationTx(ctx context.Context, invitationID int64) (AcceptOrganizationInvitationTxResult, error)
+	UpdateOrganizationMemberRoleTx(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMember, error)
+	RemoveOrganizationMemberTx(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	ReorderCollectionTx(ctx context.Context, arg ReorderCollectionTxParams) error
+	MoveCollectionTx(ctx context.Context, arg MoveCollectionTxParams) (Collection, error)
+	ForkSnippetTx(ctx context.Context, arg ForkSnippetTxParams) (ForkSnippetTxResult, error)
+	CreateSnippetTx(ctx context.Context, arg CreateSnippetTxParams) (CreateSnippetTxResult, error)
+	UpdateSnippetTx(ctx context.Context, arg UpdateSnippetTxParams) (UpdateSnippetTxResult, error)
+	CreateSnippetCommentTx(ctx context.Context, arg CreateSnippetCommentTxParams) (CreateSnippetCommentTxResult, error)
 }
 
 type SQLStore struct {
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/scipiia/snippetbox/util"
+)
+
+type AcceptOrganizationInvitationTxResult struct {
+	Invitation OrganizationInvitation
+	Member     OrganizationMember
+}
+
+// AcceptOrganizationInvitationTx marks a pending invitation accepted and adds
+// the invitee with the invited role. A member who is already in the
+// organization keeps the current role.
+func (store *SQLStore) AcceptOrganizationInvitationTx(ctx context.Context, invitationID int64) (AcceptOrganizationInvitationTxResult, error) {
+	var result AcceptOrganizationInvitationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Invitation, err = q.RespondOrganizationInvitation(ctx, RespondOrganizationInvitationParams{
+			Status: util.InvitationStatusAccepted,
+			ID:     invitationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Member, err = q.GetOrganizationMember(ctx, GetOrganizationMemberParams{
+			OrganizationID: result.Invitation.OrganizationID,
+			Username:       result.Invitation.Invitee,
+		})
+		if err == nil {
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		result.Member, err = q.CreateOrganizationMember(ctx, CreateOrganizationMemberParams{
+			OrganizationID: result.Invitation.OrganizationID,
+			Username:       result.Invitation.Invitee,
+			Role:           result.Invitation.Role,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,31 @@
+package db
+
+import "context"
+
+type CreateExportTxParams struct {
+	Owner string
+	// AfterCreate runs inside the transaction, it enqueues the export task
+	// through the outbox so an export is never left without its task.
+	AfterCreate func(q Querier, export Export) error
+}
+
+type CreateExportTxResult struct {
+	Export Export
+}
+
+func (store *SQLStore) CreateExportTx(ctx context.Context, arg CreateExportTxParams) (CreateExportTxResult, error) {
+	var result CreateExportTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Export, err = q.CreateExport(ctx, arg.Owner)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(q, result.Export)
+	})
+
+	return result, err
+}
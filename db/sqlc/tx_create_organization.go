@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/util"
+)
+
+type CreateOrganizationTxParams struct {
+	CreateOrganizationParams
+	// Owner becomes the first member of the organization
+	Owner string
+}
+
+type CreateOrganizationTxResult struct {
+	Organization Organization
+	Member       OrganizationMember
+}
+
+func (store *SQLStore) CreateOrganizationTx(ctx context.Context, arg CreateOrganizationTxParams) (CreateOrganizationTxResult, error) {
+	var result CreateOrganizationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Organization, err = q.CreateOrganization(ctx, arg.CreateOrganizationParams)
+		if err != nil {
+			return err
+		}
+
+		result.Member, err = q.CreateOrganizationMember(ctx, CreateOrganizationMemberParams{
+			OrganizationID: result.Organization.ID,
+			Username:       arg.Owner,
+			Role:           util.OrgRoleOwner,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,72 @@
+package db
+
+import "context"
+
+// SnippetFileParams is one file of a snippet, the files are positioned in the
+// order they are given.
+type SnippetFileParams struct {
+	Filename string
+	Language string
+	Content  string
+}
+
+type CreateSnippetTxParams struct {
+	CreateSnippetParams
+	Files []SnippetFileParams
+}
+
+type CreateSnippetTxResult struct {
+	Snippet Snippet
+	Files   []SnippetFile
+}
+
+func (store *SQLStore) CreateSnippetTx(ctx context.Context, arg CreateSnippetTxParams) (CreateSnippetTxResult, error) {
+	var result CreateSnippetTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Snippet, err = q.CreateSnippet(ctx, arg.CreateSnippetParams)
+		if err != nil {
+			return err
+		}
+
+		result.Files, err = saveSnippetFiles(ctx, q, result.Snippet.ID, arg.Files)
+		return err
+	})
+
+	return result, err
+}
+
+// saveSnippetFiles makes the files the only files of the snippet. Files are
+// matched by name, so a file that is kept keeps its id.
+func saveSnippetFiles(ctx context.Context, q *Queries, snippetID int32, files []SnippetFileParams) ([]SnippetFile, error) {
+	saved := make([]SnippetFile, 0, len(files))
+	filenames := make([]string, 0, len(files))
+
+	for i, file := range files {
+		snippetFile, err := q.UpsertSnippetFile(ctx, UpsertSnippetFileParams{
+			SnippetID: snippetID,
+			Filename:  file.Filename,
+			Language:  file.Language,
+			Content:   file.Content,
+			Position:  int32(i + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		saved = append(saved, snippetFile)
+		filenames = append(filenames, file.Filename)
+	}
+
+	err := q.DeleteOtherSnippetFiles(ctx, DeleteOtherSnippetFilesParams{
+		SnippetID: snippetID,
+		Filenames: filenames,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
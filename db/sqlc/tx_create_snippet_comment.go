@@ -0,0 +1,31 @@
+package db
+
+import "context"
+
+type CreateSnippetCommentTxParams struct {
+	CreateSnippetCommentParams
+	// AfterCreate runs inside the transaction with its queries, so the
+	// notification of the snippet owner is only queued with the comment.
+	AfterCreate func(q Querier, comment SnippetComment) error
+}
+
+type CreateSnippetCommentTxResult struct {
+	Comment SnippetComment
+}
+
+func (store *SQLStore) CreateSnippetCommentTx(ctx context.Context, arg CreateSnippetCommentTxParams) (CreateSnippetCommentTxResult, error) {
+	var result CreateSnippetCommentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Comment, err = q.CreateSnippetComment(ctx, arg.CreateSnippetCommentParams)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(q, result.Comment)
+	})
+
+	return result, err
+}
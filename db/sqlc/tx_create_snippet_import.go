@@ -0,0 +1,31 @@
+package db
+
+import "context"
+
+type CreateSnippetImportTxParams struct {
+	CreateSnippetImportParams
+	// AfterCreate runs inside the transaction, it enqueues the import task
+	// through the outbox so a job is never left without its task.
+	AfterCreate func(q Querier, snippetImport SnippetImport) error
+}
+
+type CreateSnippetImportTxResult struct {
+	SnippetImport SnippetImport
+}
+
+func (store *SQLStore) CreateSnippetImportTx(ctx context.Context, arg CreateSnippetImportTxParams) (CreateSnippetImportTxResult, error) {
+	var result CreateSnippetImportTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.SnippetImport, err = q.CreateSnippetImport(ctx, arg.CreateSnippetImportParams)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(q, result.SnippetImport)
+	})
+
+	return result, err
+}
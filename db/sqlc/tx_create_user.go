@@ -4,7 +4,9 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	// AfterCreate runs inside the transaction with its queries, so anything it
+	// writes (e.g. outbox tasks) is committed or rolled back with the user.
+	AfterCreate func(q Querier, user User) error
 }
 
 type CreateUserTxResult struct {
@@ -22,7 +24,7 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		return arg.AfterCreate(q, result.User)
 	})
 
 	return result, err
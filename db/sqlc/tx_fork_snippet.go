@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ForkSnippetTxParams struct {
+	SnippetID int32
+	// AccountID receives the fork
+	AccountID int32
+}
+
+type ForkSnippetTxResult struct {
+	Original Snippet
+	Fork     Snippet
+	Files    []SnippetFile
+}
+
+// ForkSnippetTx copies a snippet and its files into an account. The fork
+// starts private and keeps a reference to the original.
+func (store *SQLStore) ForkSnippetTx(ctx context.Context, arg ForkSnippetTxParams) (ForkSnippetTxResult, error) {
+	var result ForkSnippetTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Original, err = q.GetSnippet(ctx, arg.SnippetID)
+		if err != nil {
+			return err
+		}
+
+		result.Fork, err = q.CreateSnippetFork(ctx, CreateSnippetForkParams{
+			AccountID:  arg.AccountID,
+			Title:      result.Original.Title,
+			Language:   result.Original.Language,
+			ForkedFrom: sql.NullInt32{Int32: result.Original.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CopySnippetFiles(ctx, CopySnippetFilesParams{
+			ToSnippetID:   result.Fork.ID,
+			FromSnippetID: result.Original.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Files, err = q.ListSnippetFiles(ctx, result.Fork.ID)
+		return err
+	})
+
+	return result, err
+}
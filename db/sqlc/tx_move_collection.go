@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	// ErrCollectionCycle is returned by MoveCollectionTx if the new parent is
+	// the collection itself or one of its sub collections.
+	ErrCollectionCycle = errors.New("collection cannot be moved into its own subtree")
+	// ErrCollectionTooDeep is returned by MoveCollectionTx if the deepest sub
+	// collection would end up below MaxDepth.
+	ErrCollectionTooDeep = errors.New("collection would be nested too deep")
+)
+
+type MoveCollectionTxParams struct {
+	ID        int64
+	AccountID int32
+	ParentID  sql.NullInt64
+	MaxDepth  int32
+}
+
+// MoveCollectionTx moves a collection with its sub collections under a new
+// parent. The collections of the account are locked while the tree is
+// checked, so concurrent moves can't build a cycle or exceed the depth
+// between the check and the update.
+func (store *SQLStore) MoveCollectionTx(ctx context.Context, arg MoveCollectionTxParams) (Collection, error) {
+	var collection Collection
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockAccountCollections(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if arg.ParentID.Valid {
+			// one level past the limit is enough to tell the tree is too deep
+			depth, err := q.GetCollectionDepth(ctx, GetCollectionDepthParams{
+				ID:       arg.ParentID.Int64,
+				MaxDepth: arg.MaxDepth + 1,
+			})
+			if err != nil {
+				return err
+			}
+
+			subtree, err := q.ListCollectionSubtree(ctx, ListCollectionSubtreeParams{
+				ID:       arg.ID,
+				MaxLevel: arg.MaxDepth + 1,
+			})
+			if err != nil {
+				return err
+			}
+
+			var height int32
+			for _, node := range subtree {
+				if node.ID == arg.ParentID.Int64 {
+					return ErrCollectionCycle
+				}
+				if node.Level > height {
+					height = node.Level
+				}
+			}
+			if depth+height > arg.MaxDepth {
+				return ErrCollectionTooDeep
+			}
+		}
+
+		collection, err = q.MoveCollection(ctx, MoveCollectionParams{
+			ParentID: arg.ParentID,
+			ID:       arg.ID,
+		})
+		return err
+	})
+
+	return collection, err
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/scipiia/snippetbox/util"
+)
+
+// ErrLastOrganizationOwner is returned when a change would leave an
+// organization without an owner.
+var ErrLastOrganizationOwner = errors.New("organization would be left without an owner")
+
+// UpdateOrganizationMemberRoleTx changes the role of a member unless it
+// demotes the last owner. The owner rows stay locked until the change is
+// committed, so two owners can't demote each other at the same time.
+func (store *SQLStore) UpdateOrganizationMemberRoleTx(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMember, error) {
+	var member OrganizationMember
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.Role != util.OrgRoleOwner {
+			if err := checkLastOwner(ctx, q, arg.OrganizationID, arg.Username); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		member, err = q.UpdateOrganizationMemberRole(ctx, arg)
+		return err
+	})
+
+	return member, err
+}
+
+// RemoveOrganizationMemberTx removes a member unless it is the last owner.
+func (store *SQLStore) RemoveOrganizationMemberTx(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := checkLastOwner(ctx, q, arg.OrganizationID, arg.Username); err != nil {
+			return err
+		}
+
+		return q.DeleteOrganizationMember(ctx, arg)
+	})
+}
+
+// checkLastOwner locks the owners of the organization and fails if username
+// is the only one of them.
+func checkLastOwner(ctx context.Context, q *Queries, organizationID int64, username string) error {
+	owners, err := q.LockOrganizationOwners(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) == 1 && owners[0] == username {
+		return ErrLastOrganizationOwner
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const (
+	outboxBaseDelay = 5 * time.Second
+	outboxMaxDelay  = time.Hour
+)
+
+type PublishOutboxTxParams struct {
+	Limit   int32
+	Publish func(task Outbox) error
+}
+
+type PublishOutboxTxResult struct {
+	Published int
+	Failed    int
+	Dead      int
+}
+
+// PublishOutboxTx locks a batch of due outbox rows with SKIP LOCKED, so
+// several relays can run side by side without picking the same rows, and
+// hands each of them to Publish. A row is marked published only after Publish
+// succeeds; a failed row keeps its error and is retried after a backoff, until
+// it runs out of attempts and is marked dead.
+func (store *SQLStore) PublishOutboxTx(ctx context.Context, arg PublishOutboxTxParams) (PublishOutboxTxResult, error) {
+	var result PublishOutboxTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result = PublishOutboxTxResult{}
+
+		tasks, err := q.ListPendingOutboxTasks(ctx, arg.Limit)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if err := arg.Publish(task); err != nil {
+				result.Failed++
+				task, err = q.MarkOutboxTaskFailed(ctx, MarkOutboxTaskFailedParams{
+					ID: task.ID,
+					LastError: sql.NullString{
+						String: err.Error(),
+						Valid:  true,
+					},
+					NextAttemptAt: time.Now().Add(outboxRetryDelay(int(task.Attempts))),
+				})
+				if err != nil {
+					return err
+				}
+				if task.DeadAt.Valid {
+					result.Dead++
+				}
+				continue
+			}
+
+			result.Published++
+			if err := q.MarkOutboxTaskPublished(ctx, task.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// outboxRetryDelay backs off exponentially after the nth failed attempt:
+// 5s, 10s, 20s, ... up to an hour.
+func outboxRetryDelay(n int) time.Duration {
+	delay := outboxBaseDelay
+	for i := 0; i < n && delay < outboxMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxDelay {
+		delay = outboxMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCollectionMembersMismatch is returned by ReorderCollectionTx if the new
+// order doesn't list exactly the snippets of the collection.
+var ErrCollectionMembersMismatch = errors.New("snippet ids don't match the snippets of the collection")
+
+type ReorderCollectionTxParams struct {
+	CollectionID int64
+	// SnippetIDs lists every snippet of the collection in the new order
+	SnippetIDs []int32
+}
+
+// ReorderCollectionTx numbers the snippets of a collection in the given
+// order. The members are read in the transaction, so a concurrent add or
+// remove makes the reorder fail instead of leaving gaps.
+func (store *SQLStore) ReorderCollectionTx(ctx context.Context, arg ReorderCollectionTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		current, err := q.ListCollectionSnippetIDs(ctx, arg.CollectionID)
+		if err != nil {
+			return err
+		}
+
+		members := make(map[int32]bool, len(current))
+		for _, id := range current {
+			members[id] = true
+		}
+		if len(arg.SnippetIDs) != len(members) {
+			return ErrCollectionMembersMismatch
+		}
+		for _, id := range arg.SnippetIDs {
+			if !members[id] {
+				return ErrCollectionMembersMismatch
+			}
+			// a duplicate id would leave another member unnumbered
+			delete(members, id)
+		}
+
+		for i, id := range arg.SnippetIDs {
+			err = q.UpdateCollectionSnippetPosition(ctx, UpdateCollectionSnippetPositionParams{
+				Position:     int32(i + 1),
+				CollectionID: arg.CollectionID,
+				SnippetID:    id,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
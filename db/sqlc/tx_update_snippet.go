@@ -0,0 +1,43 @@
+package db
+
+import "context"
+
+type UpdateSnippetTxParams struct {
+	UpdateSnippetParams
+	// Files replace the files of the snippet, nil keeps them
+	Files []SnippetFileParams
+}
+
+type UpdateSnippetTxResult struct {
+	Snippet Snippet
+	Files   []SnippetFile
+}
+
+func (store *SQLStore) UpdateSnippetTx(ctx context.Context, arg UpdateSnippetTxParams) (UpdateSnippetTxResult, error) {
+	var result UpdateSnippetTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Snippet, err = q.UpdateSnippet(ctx, arg.UpdateSnippetParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.Files == nil {
+			result.Files, err = q.ListSnippetFiles(ctx, result.Snippet.ID)
+			return err
+		}
+
+		result.Files, err = saveSnippetFiles(ctx, q, result.Snippet.ID, arg.Files)
+		if err != nil {
+			return err
+		}
+
+		// comments on removed files or on lines past the new end of a file
+		// lose their anchor and stay as comments on the snippet
+		return q.ClearStaleSnippetCommentAnchors(ctx, result.Snippet.ID)
+	})
+
+	return result, err
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhook(t *testing.T, owner User) Webhook {
+	arg := CreateWebhookParams{
+		Owner:  owner.Name,
+		Url:    "https://" + util.RandomString(8) + ".example.com/hook",
+		Events: []string{util.EventSnippetCreated, util.EventSnippetDeleted},
+		Secret: util.RandomString(32),
+	}
+
+	webhook, err := testQueries.CreateWebhook(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, webhook)
+
+	require.Equal(t, arg.Owner, webhook.Owner)
+	require.Equal(t, arg.Url, webhook.Url)
+	require.Equal(t, arg.Events, webhook.Events)
+	require.Equal(t, arg.Secret, webhook.Secret)
+	require.True(t, webhook.IsActive)
+	require.Zero(t, webhook.FailureCount)
+	require.NotZero(t, webhook.Created)
+
+	return webhook
+}
+
+func TestCreateWebhook(t *testing.T) {
+	createRandomWebhook(t, createRandomUser(t))
+}
+
+func TestListActiveWebhooksForEvent(t *testing.T) {
+	user := createRandomUser(t)
+	webhook := createRandomWebhook(t, user)
+
+	webhooks, err := testQueries.ListActiveWebhooksForEvent(context.Background(), ListActiveWebhooksForEventParams{
+		Owner: user.Name,
+		Event: util.EventSnippetCreated,
+	})
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	require.Equal(t, webhook.ID, webhooks[0].ID)
+
+	webhooks, err = testQueries.ListActiveWebhooksForEvent(context.Background(), ListActiveWebhooksForEventParams{
+		Owner: user.Name,
+		Event: util.EventUserUpdated,
+	})
+	require.NoError(t, err)
+	require.Empty(t, webhooks)
+}
+
+func TestRecordWebhookFailure(t *testing.T) {
+	webhook := createRandomWebhook(t, createRandomUser(t))
+
+	arg := RecordWebhookFailureParams{
+		MaxFailures: 2,
+		ID:          webhook.ID,
+	}
+
+	webhook, err := testQueries.RecordWebhookFailure(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), webhook.FailureCount)
+	require.True(t, webhook.IsActive)
+
+	webhook, err = testQueries.RecordWebhookFailure(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), webhook.FailureCount)
+	require.False(t, webhook.IsActive)
+
+	// re-activating the webhook resets the failures
+	webhook, err = testQueries.UpdateWebhook(context.Background(), UpdateWebhookParams{
+		IsActive: sql.NullBool{Bool: true, Valid: true},
+		ID:       webhook.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, webhook.IsActive)
+	require.Zero(t, webhook.FailureCount)
+}
+
+func TestCreateWebhookDelivery(t *testing.T) {
+	webhook := createRandomWebhook(t, createRandomUser(t))
+
+	arg := CreateWebhookDeliveryParams{
+		WebhookID:  webhook.ID,
+		Event:      util.EventSnippetCreated,
+		Payload:    json.RawMessage(`{"event":"snippet.created"}`),
+		Attempt:    1,
+		StatusCode: sql.NullInt32{Int32: 200, Valid: true},
+		Succeeded:  true,
+	}
+
+	delivery, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.WebhookID, delivery.WebhookID)
+	require.Equal(t, arg.Event, delivery.Event)
+	require.JSONEq(t, string(arg.Payload), string(delivery.Payload))
+	require.Equal(t, arg.StatusCode, delivery.StatusCode)
+	require.True(t, delivery.Succeeded)
+
+	deliveries, err := testQueries.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		WebhookID: webhook.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, delivery.ID, deliveries[0].ID)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	webhook := createRandomWebhook(t, createRandomUser(t))
+
+	err := testQueries.DeleteWebhook(context.Background(), webhook.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetWebhook(context.Background(), webhook.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/requestid"
+)
+
+// recordAuditEvent appends an event for the caller of a gRPC call to the
+// audit log.
+func (server *Server) recordAuditEvent(ctx context.Context, actor string, action string, target string, data map[string]interface{}) {
+	server.appendAuditEvent(ctx, db.CreateAuditEventParams{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		ClientIp:  auditClientIP(ctx),
+		UserAgent: server.extractMetadata(ctx).UserAgent,
+	}, data)
+}
+
+// appendAuditEvent stores the event with the data as metadata. The action
+// itself has already happened, so a failure is only logged.
+func (server *Server) appendAuditEvent(ctx context.Context, arg db.CreateAuditEventParams, data map[string]interface{}) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	if id := requestid.FromContext(ctx); id != "" {
+		data["request_id"] = id
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("action", arg.Action).Msg("cannot marshal audit event")
+		return
+	}
+	arg.Metadata = jsonData
+
+	_, err = server.store.CreateAuditEvent(ctx, arg)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("action", arg.Action).Msg("cannot record audit event")
+	}
+}
+
+// auditClientIP returns the address of the caller. Calls of the in-process
+// gateway have no peer, the gateway appends the remote address of the HTTP
+// client to x-forwarded-for instead.
+func auditClientIP(ctx context.Context) string {
+	if ip := grpcClientIP(ctx); ip != "" {
+		return ip
+	}
+
+	return forwardedClientIP(ctx)
+}
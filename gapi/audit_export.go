@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AuditEventsExportPath streams audit events as JSON Lines, oldest first.
+// Users get their own events, admins and services may filter all of them.
+const AuditEventsExportPath = "/v1/audit_events/export"
+
+const auditExportBatchSize = 500
+
+// ExportAuditEvents writes one AuditEvent per line. The events are read in
+// batches, so large exports don't have to fit in memory.
+func (server *Server) ExportAuditEvents(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := req.Context()
+	authPayload, err := server.authorizeHTTPRequest(req, []string{util.UserRole, util.AdminRole, util.ServiceRole})
+	if err != nil {
+		writeProblem(res, req, statusError(ctx, err))
+		return
+	}
+
+	arg, err := auditExportParams(req)
+	if err != nil {
+		writeProblem(res, req, statusError(ctx, err))
+		return
+	}
+	if authPayload.Role == util.UserRole {
+		arg.Actor = sql.NullString{String: authPayload.Name, Valid: true}
+	}
+	// events recorded during the export, like its own, are left out
+	if !arg.Until.Valid {
+		arg.Until = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	marshaler := protojson.MarshalOptions{UseProtoNames: true}
+	flusher, _ := res.(http.Flusher)
+	count := 0
+
+	for first := true; ; first = false {
+		events, err := server.store.ExportAuditEvents(ctx, arg)
+		if err != nil {
+			if first {
+				writeProblem(res, req, statusError(ctx, fmt.Errorf("failed to export audit events: %w", err)))
+				return
+			}
+			log.Ctx(ctx).Error().Err(err).Int("count", count).Msg("failed to export audit events")
+			return
+		}
+
+		if first {
+			res.Header().Set("Content-Type", "application/x-ndjson")
+			res.Header().Set("Content-Disposition", `attachment; filename="audit-events.jsonl"`)
+			res.Header().Set("Cache-Control", "no-store")
+		}
+
+		for _, event := range events {
+			line, err := marshaler.Marshal(convertAuditEvent(event))
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Int64("event_id", event.ID).Msg("cannot marshal audit event")
+				return
+			}
+			if _, err := res.Write(append(line, '\n')); err != nil {
+				return
+			}
+			count++
+		}
+
+		if len(events) < auditExportBatchSize {
+			break
+		}
+		arg.AfterID = events[len(events)-1].ID
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	server.appendAuditEvent(ctx, db.CreateAuditEventParams{
+		Actor:     authPayload.Name,
+		Action:    util.AuditEventsExported,
+		Target:    util.AuditTarget("audit_events", auditExportScope(arg)),
+		ClientIp:  hostOf(req.RemoteAddr),
+		UserAgent: req.UserAgent(),
+	}, map[string]interface{}{
+		"count": count,
+		"query": req.URL.Query(),
+	})
+}
+
+// auditExportParams reads the optional actor, action, target, since and
+// until filters of the query, the times in RFC 3339.
+func auditExportParams(req *http.Request) (db.ExportAuditEventsParams, error) {
+	query := req.URL.Query()
+	arg := db.ExportAuditEventsParams{
+		LimitCount: auditExportBatchSize,
+	}
+
+	var fields []apperr.FieldViolation
+	filters := []struct {
+		name  string
+		value *sql.NullString
+	}{
+		{"actor", &arg.Actor},
+		{"action", &arg.Action},
+		{"target", &arg.Target},
+	}
+	for _, filter := range filters {
+		if !query.Has(filter.name) {
+			continue
+		}
+		value := query.Get(filter.name)
+		if err := validation.ValidateAuditFilter(value); err != nil {
+			fields = append(fields, apperr.FieldViolation{Field: filter.name, Description: err.Error()})
+			continue
+		}
+		*filter.value = sql.NullString{String: value, Valid: true}
+	}
+
+	times := []struct {
+		name  string
+		value *sql.NullTime
+	}{
+		{"since", &arg.Since},
+		{"until", &arg.Until},
+	}
+	for _, filter := range times {
+		if !query.Has(filter.name) {
+			continue
+		}
+		value, err := time.Parse(time.RFC3339, query.Get(filter.name))
+		if err != nil {
+			fields = append(fields, apperr.FieldViolation{Field: filter.name, Description: "must be a RFC 3339 time"})
+			continue
+		}
+		*filter.value = sql.NullTime{Time: value, Valid: true}
+	}
+
+	if arg.Since.Valid && arg.Until.Valid && !arg.Until.Time.After(arg.Since.Time) {
+		fields = append(fields, apperr.FieldViolation{Field: "until", Description: "must be after since"})
+	}
+
+	if len(fields) > 0 {
+		return arg, apperr.Validation(fields...)
+	}
+	return arg, nil
+}
+
+func auditExportScope(arg db.ExportAuditEventsParams) string {
+	if arg.Actor.Valid {
+		return arg.Actor.String
+	}
+	return "all"
+}
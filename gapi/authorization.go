@@ -3,9 +3,13 @@ package gapi
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/scipiia/snippetbox/apperr"
 	"github.com/scipiia/snippetbox/token"
+	"github.com/scipiia/snippetbox/util"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -14,18 +18,62 @@ const (
 	authorizationBearer = "bearer"
 )
 
-func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+// authorizeUser returns the caller of a gRPC call. A missing or invalid token
+// is an unauthenticated error, a role outside accessibleRoles a permission
+// denied one.
+func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, fmt.Errorf("missing metadata")
+		return nil, unauthenticatedError(fmt.Errorf("missing metadata"))
 	}
 
 	values := md.Get(authorizationHeader)
 	if len(values) == 0 {
-		return nil, fmt.Errorf("mission authorization header")
+		// internal services authenticate with a client certificate instead
+		if identity := clientIdentity(ctx); identity != "" {
+			return servicePayload(identity, accessibleRoles)
+		}
+		return nil, unauthenticatedError(fmt.Errorf("mission authorization header"))
 	}
 
-	authHeader := values[0]
+	payload, err := server.verifyAuthorizationHeader(values[0])
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if !hasPermission(payload.Role, accessibleRoles) {
+		return nil, permissionDeniedError()
+	}
+
+	return payload, nil
+}
+
+// authorizeHTTPRequest authorizes plain HTTP handlers of the gateway like
+// authorizeUser does for gRPC calls.
+func (server *Server) authorizeHTTPRequest(req *http.Request, accessibleRoles []string) (*token.Payload, error) {
+	authHeader := req.Header.Get(authorizationHeader)
+	if authHeader == "" {
+		if identity := clientIdentity(req.Context()); identity != "" {
+			return servicePayload(identity, accessibleRoles)
+		}
+		return nil, unauthenticatedError(fmt.Errorf("missing authorization header"))
+	}
+
+	payload, err := server.verifyAuthorizationHeader(authHeader)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if !hasPermission(payload.Role, accessibleRoles) {
+		return nil, permissionDeniedError()
+	}
+
+	return payload, nil
+}
+
+// verifyAuthorizationHeader verifies the access token of a "Bearer <token>"
+// header value.
+func (server *Server) verifyAuthorizationHeader(authHeader string) (*token.Payload, error) {
 	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
 		return nil, fmt.Errorf("invalid authorization header format")
@@ -44,3 +92,32 @@ func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error)
 
 	return payload, nil
 }
+
+// servicePayload authorizes an internal service by the identity of its
+// verified client certificate.
+func servicePayload(identity string, accessibleRoles []string) (*token.Payload, error) {
+	if !hasPermission(util.ServiceRole, accessibleRoles) {
+		return nil, permissionDeniedError()
+	}
+
+	now := time.Now()
+	return &token.Payload{
+		Name:      identity,
+		Role:      util.ServiceRole,
+		IssuedAt:  now,
+		ExpiredAt: now,
+	}, nil
+}
+
+func permissionDeniedError() error {
+	return apperr.PermissionDenied("PERMISSION_DENIED", "the role of the caller is not allowed to call this method")
+}
+
+func hasPermission(userRole string, accessibleRoles []string) bool {
+	for _, role := range accessibleRoles {
+		if userRole == role {
+			return true
+		}
+	}
+	return false
+}
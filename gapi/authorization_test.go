@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/token"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthorizeUser(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+	server := &Server{tokenMaker: maker}
+
+	userToken, _, err := maker.CreateToken(util.RandomUser(), util.UserRole, time.Minute)
+	require.NoError(t, err)
+	expiredToken, _, err := maker.CreateToken(util.RandomUser(), util.UserRole, -time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		header string
+		roles  []string
+		ok     bool
+		kind   apperr.Kind
+	}{
+		{
+			name:   "OK",
+			header: "Bearer " + userToken,
+			roles:  []string{util.UserRole},
+			ok:     true,
+		},
+		{
+			name:  "MissingHeader",
+			roles: []string{util.UserRole},
+			kind:  apperr.KindUnauthenticated,
+		},
+		{
+			name:   "UnsupportedType",
+			header: "Basic " + userToken,
+			roles:  []string{util.UserRole},
+			kind:   apperr.KindUnauthenticated,
+		},
+		{
+			name:   "ExpiredToken",
+			header: "Bearer " + expiredToken,
+			roles:  []string{util.UserRole},
+			kind:   apperr.KindUnauthenticated,
+		},
+		{
+			name:   "RoleMismatch",
+			header: "Bearer " + userToken,
+			roles:  []string{util.AdminRole},
+			kind:   apperr.KindPermissionDenied,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tc.header != "" {
+				md.Set(authorizationHeader, tc.header)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			payload, err := server.authorizeUser(ctx, tc.roles)
+			if tc.ok {
+				require.NoError(t, err)
+				require.NotNil(t, payload)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tc.kind, apperr.KindOf(err))
+		})
+	}
+}
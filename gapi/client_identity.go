@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/scipiia/snippetbox/tlsconfig"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type clientIdentityKey struct{}
+
+// HttpClientIdentity puts the identity of the verified client certificate in
+// the context, the gateway calls the server in-process so there is no gRPC
+// peer to read it from.
+func HttpClientIdentity(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if identity := tlsconfig.ClientIdentity(req.TLS); identity != "" {
+			ctx := context.WithValue(req.Context(), clientIdentityKey{}, identity)
+			req = req.WithContext(ctx)
+		}
+		handler.ServeHTTP(res, req)
+	})
+}
+
+// clientIdentity returns the identity of the verified client certificate of
+// a gRPC or gateway request, it is empty without mutual TLS.
+func clientIdentity(ctx context.Context) string {
+	if identity, ok := ctx.Value(clientIdentityKey{}).(string); ok {
+		return identity
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ""
+	}
+	return tlsconfig.ClientIdentity(&tlsInfo.State)
+}
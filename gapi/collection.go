@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+// maxCollectionDepth bounds the nesting of collections, a top level
+// collection has depth 1
+const maxCollectionDepth = 5
+
+// authorizeCollection returns the collection if the user has at least the
+// required role on its account.
+func (server *Server) authorizeCollection(ctx context.Context, id int64, username string, required string) (db.Collection, error) {
+	collection, err := server.store.GetCollection(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return collection, apperr.NotFound("COLLECTION_NOT_FOUND", "collection not found")
+		}
+		return collection, fmt.Errorf("failed to get collection: %w", err)
+	}
+
+	_, err = server.authorizeAccount(ctx, collection.AccountID, username, required)
+	if err != nil {
+		return collection, err
+	}
+
+	return collection, nil
+}
+
+// getParentCollection returns the collection new children of the account
+// can be placed in, it must be in the same account and below the depth limit.
+func (server *Server) getParentCollection(ctx context.Context, accountID int32, parentID int64) (db.Collection, error) {
+	parent, err := server.store.GetCollection(ctx, parentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return parent, apperr.NotFound("PARENT_COLLECTION_NOT_FOUND", "parent collection not found")
+		}
+		return parent, fmt.Errorf("failed to get parent collection: %w", err)
+	}
+
+	if parent.AccountID != accountID {
+		return parent, apperr.NotFound("PARENT_COLLECTION_NOT_FOUND", "parent collection not found")
+	}
+
+	return parent, nil
+}
+
+func collectionTooDeepError() error {
+	return apperr.FailedPrecondition("COLLECTION_TOO_DEEP", fmt.Sprintf("collections can be nested at most %d levels deep", maxCollectionDepth))
+}
+
+// sortCollectionTree orders the collections depth first, every collection is
+// followed by its sub collections and siblings keep their order.
+func sortCollectionTree(collections []db.Collection) []db.Collection {
+	children := make(map[int64][]db.Collection)
+	for _, collection := range collections {
+		children[collection.ParentID.Int64] = append(children[collection.ParentID.Int64], collection)
+	}
+
+	sorted := make([]db.Collection, 0, len(collections))
+	var visit func(parentID int64)
+	visit = func(parentID int64) {
+		for _, child := range children[parentID] {
+			sorted = append(sorted, child)
+			visit(child.ID)
+		}
+	}
+	visit(0)
+
+	return sorted
+}
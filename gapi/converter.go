@@ -1,8 +1,14 @@
 package gapi
 
 import (
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,3 +21,283 @@ func convertUser(user db.User) *pb.User {
 		Created:           timestamppb.New(user.Created),
 	}
 }
+
+func convertTaskQueue(queue *asynq.QueueInfo) *pb.TaskQueue {
+	return &pb.TaskQueue{
+		Name:      queue.Queue,
+		Size:      int64(queue.Size),
+		Pending:   int64(queue.Pending),
+		Active:    int64(queue.Active),
+		Scheduled: int64(queue.Scheduled),
+		Retry:     int64(queue.Retry),
+		Archived:  int64(queue.Archived),
+		Completed: int64(queue.Completed),
+		Processed: int64(queue.Processed),
+		Failed:    int64(queue.Failed),
+		Paused:    queue.Paused,
+	}
+}
+
+func convertTask(task *asynq.TaskInfo) *pb.Task {
+	rsp := &pb.Task{
+		Id:        task.ID,
+		Queue:     task.Queue,
+		Type:      task.Type,
+		State:     convertTaskState(task.State),
+		MaxRetry:  int32(task.MaxRetry),
+		Retried:   int32(task.Retried),
+		LastError: task.LastErr,
+	}
+
+	// payloads are JSON encoded, a payload which is not a JSON object is
+	// left out instead of failing the whole listing
+	payload := &structpb.Struct{}
+	if err := protojson.Unmarshal(task.Payload, payload); err == nil {
+		rsp.Payload = payload
+	}
+
+	if !task.LastFailedAt.IsZero() {
+		rsp.LastFailedAt = timestamppb.New(task.LastFailedAt)
+	}
+
+	if !task.NextProcessAt.IsZero() {
+		rsp.NextProcessAt = timestamppb.New(task.NextProcessAt)
+	}
+
+	return rsp
+}
+
+func convertTaskState(state asynq.TaskState) pb.TaskState {
+	switch state {
+	case asynq.TaskStatePending:
+		return pb.TaskState_TASK_STATE_PENDING
+	case asynq.TaskStateScheduled:
+		return pb.TaskState_TASK_STATE_SCHEDULED
+	case asynq.TaskStateRetry:
+		return pb.TaskState_TASK_STATE_RETRY
+	case asynq.TaskStateArchived:
+		return pb.TaskState_TASK_STATE_ARCHIVED
+	default:
+		return pb.TaskState_TASK_STATE_UNSPECIFIED
+	}
+}
+
+// the secret is write only and never returned
+func convertWebhook(webhook db.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:           webhook.ID,
+		Url:          webhook.Url,
+		Events:       webhook.Events,
+		IsActive:     webhook.IsActive,
+		FailureCount: webhook.FailureCount,
+		Created:      timestamppb.New(webhook.Created),
+	}
+}
+
+func convertWebhookDelivery(delivery db.WebhookDelivery) *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		Id:         delivery.ID,
+		WebhookId:  delivery.WebhookID,
+		Event:      delivery.Event,
+		Attempt:    delivery.Attempt,
+		StatusCode: delivery.StatusCode.Int32,
+		Error:      delivery.Error.String,
+		Succeeded:  delivery.Succeeded,
+		Created:    timestamppb.New(delivery.Created),
+	}
+}
+
+func convertSnippetImport(snippetImport db.SnippetImport) *pb.SnippetImport {
+	rsp := &pb.SnippetImport{
+		Id:              snippetImport.ID,
+		AccountId:       snippetImport.AccountID,
+		Format:          convertImportFormat(snippetImport.Format),
+		DuplicatePolicy: convertDuplicatePolicy(snippetImport.DuplicatePolicy),
+		Status:          snippetImport.Status,
+		Total:           snippetImport.Total,
+		CreatedCount:    snippetImport.CreatedCount,
+		UpdatedCount:    snippetImport.UpdatedCount,
+		SkippedCount:    snippetImport.SkippedCount,
+		FailedCount:     snippetImport.FailedCount,
+		Created:         timestamppb.New(snippetImport.Created),
+	}
+
+	var fileErrors []util.SnippetFileError
+	if err := json.Unmarshal(snippetImport.Errors, &fileErrors); err == nil {
+		for _, fileError := range fileErrors {
+			rsp.Errors = append(rsp.Errors, &pb.SnippetImportError{
+				Name:  fileError.Name,
+				Error: fileError.Error,
+			})
+		}
+	}
+
+	if snippetImport.FinishedAt.Valid {
+		rsp.FinishedAt = timestamppb.New(snippetImport.FinishedAt.Time)
+	}
+
+	return rsp
+}
+
+func convertImportFormat(format string) pb.ImportFormat {
+	switch format {
+	case util.ImportFormatZip:
+		return pb.ImportFormat_IMPORT_FORMAT_ZIP
+	case util.ImportFormatTar:
+		return pb.ImportFormat_IMPORT_FORMAT_TAR
+	case util.ImportFormatJSONL:
+		return pb.ImportFormat_IMPORT_FORMAT_JSONL
+	default:
+		return pb.ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func convertDuplicatePolicy(policy string) pb.DuplicatePolicy {
+	switch policy {
+	case util.DuplicatePolicySkip:
+		return pb.DuplicatePolicy_DUPLICATE_POLICY_SKIP
+	case util.DuplicatePolicyOverwrite:
+		return pb.DuplicatePolicy_DUPLICATE_POLICY_OVERWRITE
+	default:
+		return pb.DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED
+	}
+}
+
+func convertExport(export db.Export) *pb.Export {
+	rsp := &pb.Export{
+		Id:      export.ID,
+		Status:  export.Status,
+		Size:    export.Size,
+		Error:   export.Error.String,
+		Created: timestamppb.New(export.Created),
+	}
+
+	if export.FinishedAt.Valid {
+		rsp.FinishedAt = timestamppb.New(export.FinishedAt.Time)
+	}
+	if export.ExpiresAt.Valid {
+		rsp.ExpiresAt = timestamppb.New(export.ExpiresAt.Time)
+	}
+
+	return rsp
+}
+
+func convertAuditEvent(event db.AuditEvent) *pb.AuditEvent {
+	rsp := &pb.AuditEvent{
+		Id:        event.ID,
+		Actor:     event.Actor,
+		Action:    event.Action,
+		Target:    event.Target,
+		ClientIp:  event.ClientIp,
+		UserAgent: event.UserAgent,
+		Created:   timestamppb.New(event.Created),
+	}
+
+	metadata := &structpb.Struct{}
+	if err := protojson.Unmarshal(event.Metadata, metadata); err == nil {
+		rsp.Metadata = metadata
+	}
+
+	return rsp
+}
+
+// the role is the one of the requesting user
+func convertOrganization(organization db.Organization, role string) *pb.Organization {
+	return &pb.Organization{
+		Id:          organization.ID,
+		Name:        organization.Name,
+		DisplayName: organization.DisplayName,
+		Role:        role,
+		Created:     timestamppb.New(organization.Created),
+	}
+}
+
+func convertOrganizationMember(member db.OrganizationMember) *pb.OrganizationMember {
+	return &pb.OrganizationMember{
+		Username: member.Username,
+		Role:     member.Role,
+		Created:  timestamppb.New(member.Created),
+	}
+}
+
+func convertOrganizationInvitation(invitation db.OrganizationInvitation) *pb.OrganizationInvitation {
+	return &pb.OrganizationInvitation{
+		Id:             invitation.ID,
+		OrganizationId: invitation.OrganizationID,
+		Invitee:        invitation.Invitee,
+		Role:           invitation.Role,
+		InvitedBy:      invitation.InvitedBy,
+		Status:         invitation.Status,
+		Created:        timestamppb.New(invitation.Created),
+		ExpiresAt:      timestamppb.New(invitation.ExpiresAt),
+	}
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:             account.ID,
+		Login:          account.Login,
+		Username:       account.Username,
+		OrganizationId: account.OrganizationID.Int64,
+		Created:        timestamppb.New(account.Created),
+	}
+}
+
+func convertCollection(collection db.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:        collection.ID,
+		AccountId: collection.AccountID,
+		ParentId:  collection.ParentID.Int64,
+		Name:      collection.Name,
+		Created:   timestamppb.New(collection.Created),
+	}
+}
+
+func convertSnippet(snippet db.Snippet) *pb.Snippet {
+	return &pb.Snippet{
+		Id:         snippet.ID,
+		AccountId:  snippet.AccountID,
+		Title:      snippet.Title,
+		Language:   snippet.Language,
+		IsPublic:   snippet.IsPublic,
+		ForkedFrom: snippet.ForkedFrom.Int32,
+		Created:    timestamppb.New(snippet.Created),
+	}
+}
+
+func convertSnippetFiles(files []db.SnippetFile) []*pb.SnippetFile {
+	rsp := make([]*pb.SnippetFile, 0, len(files))
+	for _, file := range files {
+		rsp = append(rsp, &pb.SnippetFile{
+			Filename: file.Filename,
+			Language: file.Language,
+			Content:  file.Content,
+			Position: file.Position,
+		})
+	}
+	return rsp
+}
+
+// convertSnippetComment leaves out the body of deleted comments, they are
+// only listed to keep their replies in place.
+func convertSnippetComment(comment db.SnippetComment) *pb.SnippetComment {
+	rsp := &pb.SnippetComment{
+		Id:        comment.ID,
+		SnippetId: comment.SnippetID,
+		ParentId:  comment.ParentID.Int64,
+		Author:    comment.Author,
+		Body:      comment.Body,
+		LineStart: comment.LineStart.Int32,
+		LineEnd:   comment.LineEnd.Int32,
+		Filename:  comment.Filename.String,
+		Deleted:   comment.DeletedAt.Valid,
+		Created:   timestamppb.New(comment.Created),
+	}
+	if comment.EditedAt.Valid {
+		rsp.EditedAt = timestamppb.New(comment.EditedAt.Time)
+	}
+	if rsp.Deleted {
+		rsp.Body = ""
+	}
+	return rsp
+}
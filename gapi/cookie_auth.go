@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	csrfCookieName = "csrf_token"
+	csrfHeader     = "X-CSRF-Token"
+)
+
+// AuthCookieResponse sets the access token of a gateway LoginUser response
+// as an HTTP-only cookie, for browser clients that authenticate by cookie.
+func AuthCookieResponse(cookieName string, secure bool) func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(ctx context.Context, res http.ResponseWriter, message proto.Message) error {
+		login, ok := message.(*pb.LoginUserResponse)
+		if !ok || cookieName == "" {
+			return nil
+		}
+
+		http.SetCookie(res, &http.Cookie{
+			Name:     cookieName,
+			Value:    login.GetAccessToken(),
+			Path:     "/",
+			Expires:  login.GetAccessTokenExpiresAt().AsTime(),
+			HttpOnly: true,
+			Secure:   secure,
+			SameSite: http.SameSiteLaxMode,
+		})
+		return nil
+	}
+}
+
+// HttpCookieAuth authenticates gateway requests without an Authorization
+// header by the access token cookie. Browsers send cookies with cross-site
+// requests too, so unsafe methods must repeat the csrf_token cookie in the
+// X-CSRF-Token header, which other sites cannot read (double submit).
+func HttpCookieAuth(cookieName string, secure bool, handler http.Handler) http.Handler {
+	if cookieName == "" {
+		return handler
+	}
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		csrfToken := ""
+		if cookie, err := req.Cookie(csrfCookieName); err == nil {
+			csrfToken = cookie.Value
+		}
+		if csrfToken == "" {
+			if token, err := newCSRFToken(); err == nil {
+				http.SetCookie(res, &http.Cookie{
+					Name:     csrfCookieName,
+					Value:    token,
+					Path:     "/",
+					Secure:   secure,
+					SameSite: http.SameSiteStrictMode,
+				})
+			}
+		}
+
+		authCookie, err := req.Cookie(cookieName)
+		if req.Header.Get("Authorization") != "" || err != nil || authCookie.Value == "" {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		if !safeMethod(req.Method) && !validCSRFToken(csrfToken, req.Header.Get(csrfHeader)) {
+			writeCSRFError(res, req)
+			return
+		}
+
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", authorizationBearer+" "+authCookie.Value)
+		handler.ServeHTTP(res, req)
+	})
+}
+
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+func validCSRFToken(cookieToken string, headerToken string) bool {
+	if cookieToken == "" || headerToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookieToken), []byte(headerToken)) == 1
+}
+
+func newCSRFToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// writeCSRFError answers 403 with a problem document like the gateway errors.
+func writeCSRFError(res http.ResponseWriter, req *http.Request) {
+	writeProblem(res, req, domainStatus(apperr.PermissionDenied("CSRF_TOKEN_INVALID", "missing or invalid CSRF token")).Err())
+}
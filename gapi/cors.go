@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// response headers browser clients may read
+var corsExposedHeaders = []string{
+	"X-Request-ID",
+	"Retry-After",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+}
+
+type CORSOptions struct {
+	// "*" allows any origin, credentials are never allowed for it
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	// how long browsers cache a preflight response
+	MaxAge time.Duration
+}
+
+// HttpCORS lets the browser clients of the allowed origins call the gateway,
+// it answers their preflight requests itself. Requests of other origins get
+// no CORS headers and the browser blocks them.
+func HttpCORS(opts CORSOptions, handler http.Handler) http.Handler {
+	allowedOrigins := make(map[string]bool, len(opts.AllowedOrigins))
+	anyOrigin := false
+	for _, origin := range opts.AllowedOrigins {
+		if origin == "*" {
+			anyOrigin = true
+		}
+		allowedOrigins[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	allowedMethods := strings.Join(opts.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(opts.AllowedHeaders, ", ")
+	exposedHeaders := strings.Join(corsExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(opts.MaxAge.Seconds()))
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		header := res.Header()
+		header.Add("Vary", "Origin")
+
+		if !anyOrigin && !allowedOrigins[origin] {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		if anyOrigin && !opts.AllowCredentials {
+			header.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			header.Set("Access-Control-Allow-Origin", origin)
+		}
+		if opts.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		preflight := req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != ""
+		if !preflight {
+			header.Set("Access-Control-Expose-Headers", exposedHeaders)
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		header.Add("Vary", "Access-Control-Request-Method")
+		header.Add("Vary", "Access-Control-Request-Headers")
+		header.Set("Access-Control-Allow-Methods", allowedMethods)
+		header.Set("Access-Control-Allow-Headers", allowedHeaders)
+		header.Set("Access-Control-Max-Age", maxAge)
+		res.WriteHeader(http.StatusNoContent)
+	})
+}
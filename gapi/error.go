@@ -1,7 +1,15 @@
 package gapi
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/apperr"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,17 +23,103 @@ func fieldValidation(field string, err error) *errdetails.BadRequest_FieldViolat
 }
 
 func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) error {
-	badRequest := &errdetails.BadRequest{FieldViolations: violations}
-	statusInvalid := status.New(codes.InvalidArgument, "invalid parameters")
+	fields := make([]apperr.FieldViolation, 0, len(violations))
+	for _, violation := range violations {
+		fields = append(fields, apperr.FieldViolation{
+			Field:       violation.GetField(),
+			Description: violation.GetDescription(),
+		})
+	}
+	return apperr.Validation(fields...)
+}
+
+func unauthenticatedError(err error) error {
+	return apperr.Unauthenticated("UNAUTHENTICATED", fmt.Sprintf("unauthorized: %s", err))
+}
+
+func taskInspectorError(err error) error {
+	if errors.Is(err, asynq.ErrQueueNotFound) {
+		return apperr.NotFound("QUEUE_NOT_FOUND", err.Error())
+	}
+	if errors.Is(err, asynq.ErrTaskNotFound) {
+		return apperr.NotFound("TASK_NOT_FOUND", err.Error())
+	}
+	return fmt.Errorf("failed to inspect tasks: %w", err)
+}
+
+var kindCodes = map[apperr.Kind]codes.Code{
+	apperr.KindInternal:           codes.Internal,
+	apperr.KindValidation:         codes.InvalidArgument,
+	apperr.KindNotFound:           codes.NotFound,
+	apperr.KindAlreadyExists:      codes.AlreadyExists,
+	apperr.KindPermissionDenied:   codes.PermissionDenied,
+	apperr.KindUnauthenticated:    codes.Unauthenticated,
+	apperr.KindConflict:           codes.Aborted,
+	apperr.KindFailedPrecondition: codes.FailedPrecondition,
+	apperr.KindRateLimited:        codes.ResourceExhausted,
+	apperr.KindCanceled:           codes.Canceled,
+	apperr.KindUnavailable:        codes.Unavailable,
+}
+
+// domainStatus renders a domain error as a status with the reason in an
+// ErrorInfo and the invalid fields in a BadRequest.
+func domainStatus(appErr *apperr.Error) *status.Status {
+	st := status.New(kindCodes[appErr.Kind], appErr.Message)
 
-	statusDetails, err := statusInvalid.WithDetails(badRequest)
+	withInfo, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: appErr.Reason,
+		Domain: apperr.Domain,
+	})
 	if err != nil {
-		return statusInvalid.Err()
+		return st
+	}
+	if len(appErr.Fields) == 0 {
+		return withInfo
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range appErr.Fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field.Field,
+			Description: field.Description,
+		})
 	}
 
-	return statusDetails.Err()
+	withFields, err := withInfo.WithDetails(badRequest)
+	if err != nil {
+		return withInfo
+	}
+	return withFields
 }
 
-func unauthenticatedError(err error) error {
-	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+// statusError turns the error of a handler into a status error, status
+// errors are kept. Internal causes are logged here as clients only get a
+// generic message.
+func statusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	appErr := apperr.Map(err)
+	if appErr.Kind == apperr.KindInternal || appErr.Kind == apperr.KindUnavailable {
+		log.Ctx(ctx).Error().Err(err).Str("reason", appErr.Reason).Msg("request failed")
+	}
+
+	return domainStatus(appErr).Err()
+}
+
+// GrpcErrors renders the domain errors of the handlers, it runs after the
+// other interceptors so they see the final status. The gateway renders them
+// in HttpErrorHandler.
+func GrpcErrors(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	resp, err = handler(ctx, req)
+	return resp, statusError(ctx, err)
 }
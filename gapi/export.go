@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/blob"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+)
+
+// ExportDownloadPath serves export archives, the signed query authorizes the
+// download instead of an access token, so the link works in a browser.
+const ExportDownloadPath = "/v1/exports/download"
+
+func exportResource(exportID int64) string {
+	return fmt.Sprintf("export:%d", exportID)
+}
+
+// exportDownloadURL returns a relative link which is valid until it expires.
+func (server *Server) exportDownloadURL(export db.Export) (string, time.Time) {
+	expires := time.Now().Add(server.config.ExportURLDuration)
+	query := url.Values{}
+	query.Set("id", strconv.FormatInt(export.ID, 10))
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", server.urlSigner.Sign(exportResource(export.ID), expires))
+
+	return ExportDownloadPath + "?" + query.Encode(), expires
+}
+
+// DownloadExport streams the archive of a completed export to the client.
+func (server *Server) DownloadExport(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := req.URL.Query()
+	exportID, err := strconv.ParseInt(query.Get("id"), 10, 64)
+	if err != nil {
+		http.Error(res, "invalid export id", http.StatusBadRequest)
+		return
+	}
+	expiresUnix, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		http.Error(res, "invalid expires", http.StatusBadRequest)
+		return
+	}
+
+	err = server.urlSigner.Verify(exportResource(exportID), time.Unix(expiresUnix, 0), query.Get("signature"))
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	export, err := server.store.GetExport(req.Context(), exportID)
+	if err != nil {
+		http.Error(res, "export not found", http.StatusNotFound)
+		return
+	}
+
+	if export.Status != util.ExportStatusCompleted || !export.BlobKey.Valid {
+		http.Error(res, "export is not available", http.StatusGone)
+		return
+	}
+
+	archive, err := server.blobStore.Open(req.Context(), export.BlobKey.String)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			http.Error(res, "export is not available", http.StatusGone)
+			return
+		}
+		http.Error(res, "failed to open export", http.StatusInternalServerError)
+		return
+	}
+	defer archive.Close()
+
+	res.Header().Set("Content-Type", "application/zip")
+	res.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("snippetbox-export-%d.zip", export.ID)))
+	res.Header().Set("Content-Length", strconv.FormatInt(export.Size, 10))
+	res.Header().Set("Cache-Control", "no-store")
+
+	if _, err := io.Copy(res, archive); err != nil {
+		log.Ctx(req.Context()).Error().Err(err).Int64("export_id", export.ID).Msg("failed to send export")
+	}
+}
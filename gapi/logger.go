@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/requestid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -19,7 +21,14 @@ func GrpcLogger(
 ) (resp interface{}, err error) {
 	startTime := time.Now
 
+	requestID := grpcRequestID(ctx)
+	ctx = requestid.NewContext(ctx, requestID)
+	grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, requestID))
+
 	result, err := handler(ctx, req)
+	if err != nil {
+		err = withRequestInfo(err, requestID)
+	}
 
 	duration := time.Since(startTime())
 
@@ -28,9 +37,9 @@ func GrpcLogger(
 		statusCode = st.Code()
 	}
 
-	logger := log.Info()
+	logger := log.Ctx(ctx).Info()
 	if err != nil {
-		logger = log.Error().Err(err)
+		logger = log.Ctx(ctx).Error().Err(err)
 	}
 
 	logger.Str("protocol", "grpc").
@@ -61,6 +70,12 @@ func (rec *ResponceRecorder) Write(body []byte) (int, error) {
 func HttpLogger(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		startTime := time.Now
+
+		requestID := requestid.Accept(req.Header.Get(requestid.Header))
+		ctx := requestid.NewContext(req.Context(), requestID)
+		req = req.WithContext(ctx)
+		res.Header().Set(requestid.Header, requestID)
+
 		rec := &ResponceRecorder{
 			ResponseWriter: res,
 			statusCode:     http.StatusOK,
@@ -68,9 +83,9 @@ func HttpLogger(handler http.Handler) http.Handler {
 		handler.ServeHTTP(rec, req)
 		duration := time.Since(startTime())
 
-		logger := log.Info()
+		logger := log.Ctx(ctx).Info()
 		if rec.statusCode != http.StatusOK {
-			logger = log.Error().Bytes("body", rec.Body)
+			logger = log.Ctx(ctx).Error().Bytes("body", rec.Body)
 		}
 
 		logger.Str("protocol", "http").
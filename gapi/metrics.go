@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/scipiia/snippetbox/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func GrpcMetrics(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	startTime := time.Now()
+
+	result, err := handler(ctx, req)
+
+	code := status.Code(err).String()
+	metrics.GRPCRequests.WithLabelValues(info.FullMethod, code).Inc()
+	metrics.GRPCDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(startTime).Seconds())
+
+	return result, err
+}
+
+// HttpMetrics counts the requests of one handler, the name keeps the labels
+// bounded whatever the paths are.
+func HttpMetrics(name string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		startTime := time.Now()
+		rec := &ResponceRecorder{
+			ResponseWriter: res,
+			statusCode:     http.StatusOK,
+		}
+		handler.ServeHTTP(rec, req)
+
+		code := strconv.Itoa(rec.statusCode)
+		metrics.HTTPRequests.WithLabelValues(name, req.Method, code).Inc()
+		metrics.HTTPDuration.WithLabelValues(name, req.Method, code).Observe(time.Since(startTime).Seconds())
+	})
+}
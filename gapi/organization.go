@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+)
+
+// organizationInvitationTTL is how long an invitation can be accepted
+const organizationInvitationTTL = 7 * 24 * time.Hour
+
+// authorizeOrganization returns the membership of the user if it has at least
+// the required role. Organizations of other users are reported as not found.
+func (server *Server) authorizeOrganization(ctx context.Context, organizationID int64, username string, required string) (db.OrganizationMember, error) {
+	member, err := server.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: organizationID,
+		Username:       username,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return member, apperr.NotFound("ORGANIZATION_NOT_FOUND", "organization not found")
+		}
+		return member, fmt.Errorf("failed to get organization member: %w", err)
+	}
+
+	if !util.HasOrgRole(member.Role, required) {
+		return member, apperr.PermissionDenied("ORGANIZATION_ROLE_REQUIRED", fmt.Sprintf("requires the %s role in the organization", required))
+	}
+
+	return member, nil
+}
+
+// authorizeAccount returns the account if the user has at least the required
+// role on it, see db.AccountRole.
+func (server *Server) authorizeAccount(ctx context.Context, accountID int32, username string, required string) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, apperr.NotFound("ACCOUNT_NOT_FOUND", "account not found")
+		}
+		return account, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	role, err := db.AccountRole(ctx, server.store, account, username)
+	if err != nil {
+		return account, fmt.Errorf("failed to get account role: %w", err)
+	}
+
+	if !util.HasOrgRole(role, required) {
+		return account, apperr.PermissionDenied("ACCOUNT_ACCESS_DENIED", "not allowed to access the snippets of the account")
+	}
+
+	return account, nil
+}
+
+func lastOwnerError() error {
+	return apperr.FailedPrecondition("LAST_ORGANIZATION_OWNER", "the last owner of an organization cannot be removed or demoted")
+}
@@ -0,0 +1,114 @@
+package gapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/requestid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypeBase    = "/problems/"
+)
+
+// newProblem renders a status as an RFC 7807 document, the reason, request
+// id and field violations come from the details.
+func newProblem(st *status.Status, httpStatus int, instance string) *pb.Problem {
+	problem := &pb.Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(httpStatus),
+		Status:   int32(httpStatus),
+		Detail:   st.Message(),
+		Instance: instance,
+	}
+	if problem.Title == "" {
+		problem.Title = st.Code().String()
+	}
+
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			problem.Reason = detail.GetReason()
+			problem.Type = problemType(detail.GetReason())
+		case *errdetails.RequestInfo:
+			problem.RequestId = detail.GetRequestId()
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				problem.InvalidParams = append(problem.InvalidParams, &pb.InvalidParam{
+					Name:   violation.GetField(),
+					Reason: violation.GetDescription(),
+				})
+			}
+		}
+	}
+
+	return problem
+}
+
+// problemType turns a reason like USER_NOT_FOUND into /problems/user-not-found.
+func problemType(reason string) string {
+	if reason == "" {
+		return "about:blank"
+	}
+	return problemTypeBase + strings.ReplaceAll(strings.ToLower(reason), "_", "-")
+}
+
+func marshalProblem(problem *pb.Problem) ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(problem)
+}
+
+// problemMarshaler lets the default error handler write problem documents,
+// so it still forwards the metadata and trailers of the failed call.
+type problemMarshaler struct {
+	runtime.Marshaler
+	httpStatus int
+	instance   string
+}
+
+func (marshaler problemMarshaler) ContentType(v interface{}) string {
+	return problemContentType
+}
+
+func (marshaler problemMarshaler) Marshal(v interface{}) ([]byte, error) {
+	st, ok := v.(*spb.Status)
+	if !ok {
+		return marshaler.Marshaler.Marshal(v)
+	}
+	return marshalProblem(newProblem(status.FromProto(st), marshaler.httpStatus, marshaler.instance))
+}
+
+// httpStatusOf returns the HTTP status the gateway answers an error with.
+func httpStatusOf(err error) int {
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		return customStatus.HTTPStatus
+	}
+	return runtime.HTTPStatusFromCode(status.Code(err))
+}
+
+// writeProblem answers a status error of a middleware with a problem
+// document, like HttpErrorHandler does for the gateway.
+func writeProblem(res http.ResponseWriter, req *http.Request, err error) {
+	if id := requestid.FromContext(req.Context()); id != "" {
+		err = withRequestInfo(err, id)
+	}
+
+	httpStatus := httpStatusOf(err)
+	body, marshalErr := marshalProblem(newProblem(status.Convert(err), httpStatus, req.URL.Path))
+	if marshalErr != nil {
+		httpStatus = http.StatusInternalServerError
+		body = []byte(`{"type":"about:blank","title":"Internal Server Error","status":500}`)
+	}
+
+	res.Header().Set("Content-Type", problemContentType)
+	res.WriteHeader(httpStatus)
+	res.Write(body)
+}
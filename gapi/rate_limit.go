@@ -0,0 +1,218 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/ratelimit"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	retryAfterHeader         = "Retry-After"
+	forwardedForHeader       = "x-forwarded-for"
+)
+
+// gatewayMethods maps "GET /v1/..." routes of the gateway to RPC names, so
+// per-method limits apply to both protocols.
+var gatewayMethods = loadGatewayMethods()
+
+func loadGatewayMethods() map[string]string {
+	routes := make(map[string]string)
+
+	services := pb.File_service_snippetbox_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			options, ok := method.Options().(*descriptorpb.MethodOptions)
+			if !ok {
+				continue
+			}
+
+			rule, ok := proto.GetExtension(options, annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+
+			switch pattern := rule.GetPattern().(type) {
+			case *annotations.HttpRule_Get:
+				routes[http.MethodGet+" "+pattern.Get] = string(method.Name())
+			case *annotations.HttpRule_Post:
+				routes[http.MethodPost+" "+pattern.Post] = string(method.Name())
+			case *annotations.HttpRule_Put:
+				routes[http.MethodPut+" "+pattern.Put] = string(method.Name())
+			case *annotations.HttpRule_Patch:
+				routes[http.MethodPatch+" "+pattern.Patch] = string(method.Name())
+			case *annotations.HttpRule_Delete:
+				routes[http.MethodDelete+" "+pattern.Delete] = string(method.Name())
+			}
+		}
+	}
+
+	return routes
+}
+
+// rateLimitUser returns the name of the authenticated user, or an empty
+// string for anonymous calls and invalid tokens.
+func (server *Server) rateLimitUser(authHeader string) string {
+	if authHeader == "" {
+		return ""
+	}
+
+	payload, err := server.verifyAuthorizationHeader(authHeader)
+	if err != nil {
+		return ""
+	}
+	return payload.Name
+}
+
+// allow checks the limits of a call, a failing limiter lets the call pass.
+func (server *Server) allow(ctx context.Context, method, authHeader, ip string) (ratelimit.Result, bool) {
+	result, err := server.rateLimiter.Allow(ctx, method, server.rateLimitUser(authHeader), ip)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to check rate limit")
+		return ratelimit.Result{}, false
+	}
+	return result, true
+}
+
+func rateLimitExceededStatus(result ratelimit.Result) *status.Status {
+	st := domainStatus(apperr.New(apperr.KindRateLimited, "RATE_LIMITED", "rate limit exceeded"))
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(result.RetryAfter),
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+func (server *Server) GrpcRateLimiter(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	if server.rateLimiter == nil {
+		return handler(ctx, req)
+	}
+
+	var authHeader string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			authHeader = values[0]
+		}
+	}
+
+	result, ok := server.allow(ctx, path.Base(info.FullMethod), authHeader, grpcClientIP(ctx))
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	md := metadata.Pairs(
+		rateLimitLimitHeader, strconv.Itoa(result.Limit),
+		rateLimitRemainingHeader, strconv.Itoa(result.Remaining),
+	)
+	if !result.Allowed {
+		md.Set(retryAfterHeader, strconv.Itoa(result.RetryAfterSeconds()))
+	}
+	grpc.SetHeader(ctx, md)
+
+	if !result.Allowed {
+		return nil, rateLimitExceededStatus(result).Err()
+	}
+
+	return handler(ctx, req)
+}
+
+// HttpRateLimiter limits the gateway, denied requests get 429 with the
+// Retry-After header and a problem document like the gateway errors.
+func (server *Server) HttpRateLimiter(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if server.rateLimiter == nil {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		ctx := req.Context()
+		method := gatewayMethods[req.Method+" "+req.URL.Path]
+
+		result, ok := server.allow(ctx, method, req.Header.Get("Authorization"), hostOf(req.RemoteAddr))
+		if !ok {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		res.Header().Set(rateLimitLimitHeader, strconv.Itoa(result.Limit))
+		res.Header().Set(rateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+
+		if result.Allowed {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		res.Header().Set(retryAfterHeader, strconv.Itoa(result.RetryAfterSeconds()))
+		writeProblem(res, req, rateLimitExceededStatus(result).Err())
+	})
+}
+
+// grpcClientIP returns the address of the caller. Calls of the gateway over a
+// loopback client connection carry the address of the HTTP client in
+// x-forwarded-for, which is trusted from loopback peers only.
+func grpcClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	ip := hostOf(p.Addr.String())
+
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.IsLoopback() {
+		if forwarded := forwardedClientIP(ctx); forwarded != "" {
+			return forwarded
+		}
+	}
+
+	return ip
+}
+
+// forwardedClientIP returns the last hop of x-forwarded-for. The gateway
+// appends the remote address of the HTTP client to the hops it received, the
+// earlier hops are set by the client and can't be trusted.
+func forwardedClientIP(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	forwarded := md.Get(forwardedForHeader)
+	if len(forwarded) == 0 {
+		return ""
+	}
+	hops := strings.Split(forwarded[len(forwarded)-1], ",")
+	return strings.TrimSpace(hops[len(hops)-1])
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
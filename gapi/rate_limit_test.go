@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGrpcClientIP(t *testing.T) {
+	testCases := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{
+			name: "Peer",
+			peer: "203.0.113.7",
+			want: "203.0.113.7",
+		},
+		{
+			name:      "RemotePeerIgnoresForwarded",
+			peer:      "203.0.113.7",
+			forwarded: []string{"198.51.100.1"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "LoopbackPeerForwarded",
+			peer:      "127.0.0.1",
+			forwarded: []string{"198.51.100.1"},
+			want:      "198.51.100.1",
+		},
+		{
+			name:      "LoopbackPeerSpoofedHops",
+			peer:      "127.0.0.1",
+			forwarded: []string{"10.0.0.1, 192.0.2.9, 198.51.100.1"},
+			want:      "198.51.100.1",
+		},
+		{
+			name: "LoopbackPeerNotForwarded",
+			peer: "127.0.0.1",
+			want: "127.0.0.1",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(tc.peer), Port: 4000},
+			})
+			if tc.forwarded != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{forwardedForHeader: tc.forwarded})
+			}
+
+			require.Equal(t, tc.want, grpcClientIP(ctx))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/scipiia/snippetbox/requestid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcRequestID returns the id the gateway or the client sent in the
+// metadata, or a new one.
+func grpcRequestID(ctx context.Context) string {
+	if id := requestid.FromContext(ctx); id != "" {
+		return id
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestid.MetadataKey); len(ids) > 0 {
+			return requestid.Accept(ids[0])
+		}
+	}
+
+	return requestid.New()
+}
+
+// withRequestInfo adds the request id to the details of a status error, so
+// clients can report it.
+func withRequestInfo(err error, id string) error {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.RequestInfo); ok {
+			return err
+		}
+	}
+
+	withDetails, detailErr := st.WithDetails(&errdetails.RequestInfo{RequestId: id})
+	if detailErr != nil {
+		return err
+	}
+	return withDetails.Err()
+}
+
+// HttpErrorHandler writes gateway errors as RFC 7807 problem documents, with
+// the domain errors rendered as for gRPC and the request id of the call.
+func HttpErrorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	res http.ResponseWriter,
+	req *http.Request,
+	err error,
+) {
+	// routing errors carry their own HTTP status around the status error
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+	}
+
+	err = statusError(ctx, err)
+	if id := requestid.FromContext(ctx); id != "" {
+		err = withRequestInfo(err, id)
+	}
+	if customStatus != nil {
+		err = &runtime.HTTPStatusError{HTTPStatus: customStatus.HTTPStatus, Err: err}
+	}
+
+	marshaler = problemMarshaler{
+		Marshaler:  marshaler,
+		httpStatus: httpStatusOf(err),
+		instance:   req.URL.Path,
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, res, req, err)
+}
+
+// GatewayMetadata forwards the request id of a gateway call, so a gRPC
+// server behind a gateway client connection keeps it.
+func GatewayMetadata(ctx context.Context, req *http.Request) metadata.MD {
+	if id := requestid.FromContext(ctx); id != "" {
+		return metadata.Pairs(requestid.MetadataKey, id)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) AddCollectionSnippet(ctx context.Context, req *pb.AddCollectionSnippetRequest) (*pb.AddCollectionSnippetResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAddCollectionSnippetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	collection, err := server.authorizeCollection(ctx, req.GetCollectionId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	// only snippets of the account of the collection can be added
+	snippet, err := server.store.GetSnippet(ctx, req.GetSnippetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+		}
+		return nil, fmt.Errorf("failed to get snippet: %w", err)
+	}
+	if snippet.AccountID != collection.AccountID {
+		return nil, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+	}
+
+	member, err := server.store.AddCollectionSnippet(ctx, db.AddCollectionSnippetParams{
+		CollectionID: collection.ID,
+		SnippetID:    snippet.ID,
+	})
+	if err != nil {
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("COLLECTION_SNIPPET_EXISTS", "snippet is already in the collection").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to add snippet to collection: %w", err)
+	}
+
+	rsp := &pb.AddCollectionSnippetResponse{
+		Position: member.Position,
+	}
+
+	return rsp, nil
+}
+
+func validateAddCollectionSnippetRequest(req *pb.AddCollectionSnippetRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetCollectionId()); err != nil {
+		validations = append(validations, fieldValidation("collection_id", err))
+	}
+
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateCollectionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateCollectionParams{
+		AccountID: account.ID,
+		Name:      req.GetName(),
+	}
+
+	if req.GetParentId() != 0 {
+		parent, err := server.getParentCollection(ctx, account.ID, req.GetParentId())
+		if err != nil {
+			return nil, err
+		}
+
+		depth, err := server.store.GetCollectionDepth(ctx, db.GetCollectionDepthParams{
+			ID:       parent.ID,
+			MaxDepth: maxCollectionDepth + 1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get collection depth: %w", err)
+		}
+		if depth >= maxCollectionDepth {
+			return nil, collectionTooDeepError()
+		}
+		arg.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+
+	collection, err := server.store.CreateCollection(ctx, arg)
+	if err != nil {
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("COLLECTION_ALREADY_EXISTS", "a collection with this name already exists here").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	rsp := &pb.CreateCollectionResponse{
+		Collection: convertCollection(collection),
+	}
+
+	return rsp, nil
+}
+
+func validateCreateCollectionRequest(req *pb.CreateCollectionRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetAccountId())); err != nil {
+		validations = append(validations, fieldValidation("account_id", err))
+	}
+
+	if err := validation.ValidateCollectionName(req.GetName()); err != nil {
+		validations = append(validations, fieldValidation("name", err))
+	}
+
+	if req.GetParentId() != 0 {
+		if err := validation.ValidateID(req.GetParentId()); err != nil {
+			validations = append(validations, fieldValidation("parent_id", err))
+		}
+	}
+
+	return validations
+}
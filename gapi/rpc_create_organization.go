@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateOrganizationRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateOrganizationTxParams{
+		CreateOrganizationParams: db.CreateOrganizationParams{
+			Name:        req.GetName(),
+			DisplayName: req.GetDisplayName(),
+		},
+		Owner: authPayload.Name,
+	}
+
+	txResult, err := server.store.CreateOrganizationTx(ctx, arg)
+	if err != nil {
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("ORGANIZATION_ALREADY_EXISTS", "organization name already exists").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditOrgCreated, util.AuditTarget("organization", txResult.Organization.ID), map[string]interface{}{
+		"name": txResult.Organization.Name,
+	})
+
+	rsp := &pb.CreateOrganizationResponse{
+		Organization: convertOrganization(txResult.Organization, txResult.Member.Role),
+	}
+
+	return rsp, nil
+}
+
+func validateCreateOrganizationRequest(req *pb.CreateOrganizationRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateName(req.GetName()); err != nil {
+		validations = append(validations, fieldValidation("name", err))
+	}
+
+	if err := validation.ValidateDisplayName(req.GetDisplayName()); err != nil {
+		validations = append(validations, fieldValidation("display_name", err))
+	}
+
+	return validations
+}
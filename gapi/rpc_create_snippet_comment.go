@@ -0,0 +1,140 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"github.com/scipiia/snippetbox/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateSnippetComment(ctx context.Context, req *pb.CreateSnippetCommentRequest) (*pb.CreateSnippetCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateSnippetCommentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateSnippetCommentParams{
+		SnippetID: snippet.ID,
+		Author:    authPayload.Name,
+		Body:      req.GetBody(),
+	}
+
+	if req.ParentId != nil {
+		parent, err := server.store.GetSnippetComment(ctx, req.GetParentId())
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("failed to get parent comment: %w", err)
+		}
+		if err == sql.ErrNoRows || parent.SnippetID != snippet.ID {
+			return nil, apperr.NotFound("COMMENT_NOT_FOUND", "parent comment not found")
+		}
+		if parent.ParentID.Valid {
+			return nil, apperr.FailedPrecondition("COMMENT_REPLY_TOO_DEEP", "replies can only be made to top level comments")
+		}
+		if parent.DeletedAt.Valid {
+			return nil, apperr.FailedPrecondition("COMMENT_DELETED", "cannot reply to a deleted comment")
+		}
+		arg.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+
+	if req.LineStart != nil {
+		file, err := server.store.GetSnippetFile(ctx, db.GetSnippetFileParams{
+			SnippetID: snippet.ID,
+			Filename:  req.GetFilename(),
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+					fieldValidation("filename", fmt.Errorf("must be a file of the snippet")),
+				})
+			}
+			return nil, fmt.Errorf("failed to get snippet file: %w", err)
+		}
+
+		if req.GetLineEnd() > snippetLineCount(file.Content) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldValidation("line_end", fmt.Errorf("must not be after the last line of the file")),
+			})
+		}
+		arg.LineStart = sql.NullInt32{Int32: req.GetLineStart(), Valid: true}
+		arg.LineEnd = sql.NullInt32{Int32: req.GetLineEnd(), Valid: true}
+		arg.Filename = sql.NullString{String: file.Filename, Valid: true}
+	}
+
+	account, err := server.store.GetAccount(ctx, snippet.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	txResult, err := server.store.CreateSnippetCommentTx(ctx, db.CreateSnippetCommentTxParams{
+		CreateSnippetCommentParams: arg,
+		AfterCreate: func(q db.Querier, comment db.SnippetComment) error {
+			// owners are not told about their own comments
+			if comment.Author == account.Login {
+				return nil
+			}
+
+			taskPayload := &worker.PayloadNotifySnippetComment{
+				CommentID: comment.ID,
+				Recipient: account.Login,
+			}
+			taskDistributor := worker.NewOutboxTaskDistributor(q)
+			return worker.DistributeTaskNotifySnippetComment(ctx, taskDistributor, taskPayload, worker.NotifySnippetCommentOptions()...)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	rsp := &pb.CreateSnippetCommentResponse{
+		Comment: convertSnippetComment(txResult.Comment),
+	}
+
+	return rsp, nil
+}
+
+func validateCreateSnippetCommentRequest(req *pb.CreateSnippetCommentRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	if err := validation.ValidateCommentBody(req.GetBody()); err != nil {
+		validations = append(validations, fieldValidation("body", err))
+	}
+
+	if req.ParentId != nil {
+		if err := validation.ValidateID(req.GetParentId()); err != nil {
+			validations = append(validations, fieldValidation("parent_id", err))
+		}
+	}
+
+	if req.LineStart != nil || req.LineEnd != nil || req.Filename != nil {
+		if req.LineStart == nil || req.LineEnd == nil || req.Filename == nil {
+			validations = append(validations, fieldValidation("line_end", fmt.Errorf("must be set together with line_start and filename")))
+		} else if err := validation.ValidateLineRange(req.GetLineStart(), req.GetLineEnd()); err != nil {
+			validations = append(validations, fieldValidation("line_start", err))
+		}
+
+		if req.ParentId != nil {
+			validations = append(validations, fieldValidation("line_start", fmt.Errorf("replies cannot be anchored to lines")))
+		}
+	}
+
+	return validations
+}
@@ -2,19 +2,17 @@ package gapi
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"github.com/hibiken/asynq"
-	"github.com/lib/pq"
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/pb"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/scipiia/snippetbox/validation"
 	"github.com/scipiia/snippetbox/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
@@ -35,7 +33,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 
 	hashedPassword, err := util.HashedPassword(req.GetPassword())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to hsah password: %s", err)
+		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	arg := db.CreateUserTxParams{
@@ -45,7 +43,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
+		AfterCreate: func(q db.Querier, user db.User) error {
 			taskPayload := &worker.PayloadSendVerifyEmail{
 				Name: user.Name,
 			}
@@ -55,20 +53,19 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 				asynq.Queue(worker.QueueCritical),
 			}
 
-			return server.taskDistributer.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+			// stored in the outbox within the user transaction, the relay
+			// pushes it to Redis once the user is committed
+			taskDistributor := worker.NewOutboxTaskDistributor(q)
+			return worker.DistributeTaskSendVerifyEmail(ctx, taskDistributor, taskPayload, opts...)
 		},
 	}
 
 	txResult, err := server.store.CreateUserTx(ctx, arg)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			log.Println(pqError.Code.Name())
-			switch pqError.Code.Name() {
-			case "unique_violation":
-				return nil, status.Errorf(codes.AlreadyExists, "name already exists %s", err)
-			}
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("USER_ALREADY_EXISTS", "name or email already exists").WithCause(err)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to create user %s", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
 	rsp := &pb.CreateUserResponse{
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateWebhookParams{
+		Owner:  authPayload.Name,
+		Url:    req.GetUrl(),
+		Events: req.GetEvents(),
+		Secret: req.GetSecret(),
+	}
+
+	webhook, err := server.store.CreateWebhook(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	rsp := &pb.CreateWebhookResponse{
+		Webhook: convertWebhook(webhook),
+	}
+
+	return rsp, nil
+}
+
+func validateCreateWebhookRequest(req *pb.CreateWebhookRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateWebhookURL(req.GetUrl()); err != nil {
+		validations = append(validations, fieldValidation("url", err))
+	}
+
+	if err := validation.ValidateWebhookEvents(req.GetEvents()); err != nil {
+		validations = append(validations, fieldValidation("events", err))
+	}
+
+	if err := validation.ValidateWebhookSecret(req.GetSecret()); err != nil {
+		validations = append(validations, fieldValidation("secret", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// DeleteCollection deletes the collection and its sub collections, the
+// snippets in them stay in the account.
+func (server *Server) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteCollectionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	collection, err := server.authorizeCollection(ctx, req.GetId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeleteCollection(ctx, collection.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete collection: %w", err)
+	}
+
+	return &pb.DeleteCollectionResponse{}, nil
+}
+
+func validateDeleteCollectionRequest(req *pb.DeleteCollectionRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// DeleteSnippetComment soft deletes a comment, its replies stay. Besides the
+// author, the users that can change the snippet may delete any comment on it.
+func (server *Server) DeleteSnippetComment(ctx context.Context, req *pb.DeleteSnippetCommentRequest) (*pb.DeleteSnippetCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteSnippetCommentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	comment, snippet, err := server.getReadableSnippetComment(ctx, req.GetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Author != authPayload.Name {
+		_, err = server.authorizeAccount(ctx, snippet.AccountID, authPayload.Name, util.OrgRoleMember)
+		if err != nil {
+			if apperr.KindOf(err) == apperr.KindPermissionDenied {
+				return nil, apperr.PermissionDenied("COMMENT_DELETE_DENIED", "only the author or the snippet owner can delete a comment")
+			}
+			return nil, err
+		}
+	}
+
+	_, err = server.store.DeleteSnippetComment(ctx, db.DeleteSnippetCommentParams{
+		ID:        comment.ID,
+		DeletedBy: sql.NullString{String: authPayload.Name, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("COMMENT_NOT_FOUND", "comment not found")
+		}
+		return nil, fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditCommentDeleted, util.AuditTarget("comment", comment.ID), map[string]interface{}{
+		"snippet_id": snippet.ID,
+		"author":     comment.Author,
+	})
+
+	return &pb.DeleteSnippetCommentResponse{}, nil
+}
+
+func validateDeleteSnippetCommentRequest(req *pb.DeleteSnippetCommentRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteTaskRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.taskInspector.DeleteTask(req.GetQueue(), req.GetId())
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	return &pb.DeleteTaskResponse{}, nil
+}
+
+func validateDeleteTaskRequest(req *pb.DeleteTaskRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateQueueName(req.GetQueue()); err != nil {
+		validations = append(validations, fieldValidation("queue", err))
+	}
+
+	if err := validation.ValidateTaskID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getUserWebhook(ctx, authPayload.Name, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeleteWebhook(ctx, req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+func validateDeleteWebhookRequest(req *pb.DeleteWebhookRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
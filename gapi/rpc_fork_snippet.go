@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ForkSnippet(ctx context.Context, req *pb.ForkSnippetRequest) (*pb.ForkSnippetResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateForkSnippetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	original, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult, err := server.store.ForkSnippetTx(ctx, db.ForkSnippetTxParams{
+		SnippetID: original.ID,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+		}
+		return nil, fmt.Errorf("failed to fork snippet: %w", err)
+	}
+
+	rsp := &pb.ForkSnippetResponse{
+		Snippet: convertSnippet(txResult.Fork),
+	}
+	rsp.Snippet.Files = convertSnippetFiles(txResult.Files)
+
+	server.publishWebhookEvent(ctx, util.EventSnippetCreated, account.Login, rsp.Snippet)
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditSnippetForked, util.AuditTarget("snippet", txResult.Fork.ID), map[string]interface{}{
+		"account_id":  account.ID,
+		"forked_from": txResult.Original.ID,
+	})
+
+	return rsp, nil
+}
+
+func validateForkSnippetRequest(req *pb.ForkSnippetRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	if err := validation.ValidateID(int64(req.GetAccountId())); err != nil {
+		validations = append(validations, fieldValidation("account_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetExport(ctx context.Context, req *pb.GetExportRequest) (*pb.GetExportResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetExportRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	export, err := server.store.GetExport(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("EXPORT_NOT_FOUND", "export not found")
+		}
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+
+	if export.Owner != authPayload.Name {
+		return nil, apperr.PermissionDenied("EXPORT_NOT_OWNED", "export doesn't belong to the authenticated user")
+	}
+
+	rsp := &pb.GetExportResponse{
+		Export: convertExport(export),
+	}
+
+	if export.Status == util.ExportStatusCompleted {
+		downloadURL, expires := server.exportDownloadURL(export)
+		rsp.Export.DownloadUrl = downloadURL
+		rsp.Export.DownloadUrlExpiresAt = timestamppb.New(expires)
+	}
+
+	return rsp, nil
+}
+
+func validateGetExportRequest(req *pb.GetExportRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
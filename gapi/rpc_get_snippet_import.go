@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetSnippetImport(ctx context.Context, req *pb.GetSnippetImportRequest) (*pb.GetSnippetImportResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetSnippetImportRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	snippetImport, err := server.store.GetSnippetImport(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("SNIPPET_IMPORT_NOT_FOUND", "snippet import not found")
+		}
+		return nil, fmt.Errorf("failed to get snippet import: %w", err)
+	}
+
+	// the members of a shared account can follow its imports
+	if snippetImport.Owner != authPayload.Name {
+		_, err = server.authorizeAccount(ctx, snippetImport.AccountID, authPayload.Name, util.OrgRoleViewer)
+		if err != nil {
+			if kind := apperr.KindOf(err); kind == apperr.KindNotFound || kind == apperr.KindPermissionDenied {
+				return nil, apperr.PermissionDenied("SNIPPET_IMPORT_NOT_OWNED", "snippet import doesn't belong to the authenticated user")
+			}
+			return nil, err
+		}
+	}
+
+	rsp := &pb.GetSnippetImportResponse{
+		SnippetImport: convertSnippetImport(snippetImport),
+	}
+
+	return rsp, nil
+}
+
+func validateGetSnippetImportRequest(req *pb.GetSnippetImportRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetSnippetStats(ctx context.Context, req *pb.GetSnippetStatsRequest) (*pb.GetSnippetStatsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetSnippetStatsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	stars, err := server.store.CountSnippetStars(ctx, snippet.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snippet stars: %w", err)
+	}
+
+	forks, err := server.store.CountSnippetForks(ctx, sql.NullInt32{Int32: snippet.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snippet forks: %w", err)
+	}
+
+	starred, err := server.store.IsSnippetStarred(ctx, db.IsSnippetStarredParams{
+		Username:  authPayload.Name,
+		SnippetID: snippet.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snippet star: %w", err)
+	}
+
+	rsp := &pb.GetSnippetStatsResponse{
+		StarCount: stars,
+		ForkCount: forks,
+		Starred:   starred,
+	}
+
+	return rsp, nil
+}
+
+func validateGetSnippetStatsRequest(req *pb.GetSnippetStatsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	return validations
+}
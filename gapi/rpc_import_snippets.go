@@ -0,0 +1,108 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"github.com/scipiia/snippetbox/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ImportSnippets(ctx context.Context, req *pb.ImportSnippetsRequest) (*pb.ImportSnippetsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateImportSnippetsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// members of an organization can import into its accounts
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	format, _ := importFormatFromPb(req.GetFormat())
+	policy, _ := duplicatePolicyFromPb(req.GetDuplicatePolicy())
+
+	arg := db.CreateSnippetImportTxParams{
+		CreateSnippetImportParams: db.CreateSnippetImportParams{
+			Owner:           authPayload.Name,
+			AccountID:       account.ID,
+			Format:          format,
+			DuplicatePolicy: policy,
+			Archive:         req.GetArchive(),
+		},
+		AfterCreate: func(q db.Querier, snippetImport db.SnippetImport) error {
+			taskPayload := &worker.PayloadImportSnippets{
+				ImportID: snippetImport.ID,
+			}
+
+			taskDistributor := worker.NewOutboxTaskDistributor(q)
+			return worker.DistributeTaskImportSnippets(ctx, taskDistributor, taskPayload, worker.ImportSnippetsOptions()...)
+		},
+	}
+
+	txResult, err := server.store.CreateSnippetImportTx(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snippet import: %w", err)
+	}
+
+	rsp := &pb.ImportSnippetsResponse{
+		SnippetImport: convertSnippetImport(txResult.SnippetImport),
+	}
+
+	return rsp, nil
+}
+
+func importFormatFromPb(format pb.ImportFormat) (string, error) {
+	switch format {
+	case pb.ImportFormat_IMPORT_FORMAT_ZIP:
+		return util.ImportFormatZip, nil
+	case pb.ImportFormat_IMPORT_FORMAT_TAR:
+		return util.ImportFormatTar, nil
+	case pb.ImportFormat_IMPORT_FORMAT_JSONL:
+		return util.ImportFormatJSONL, nil
+	default:
+		return "", fmt.Errorf("must be one of zip, tar or jsonl")
+	}
+}
+
+// duplicates are skipped unless asked otherwise
+func duplicatePolicyFromPb(policy pb.DuplicatePolicy) (string, error) {
+	switch policy {
+	case pb.DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED, pb.DuplicatePolicy_DUPLICATE_POLICY_SKIP:
+		return util.DuplicatePolicySkip, nil
+	case pb.DuplicatePolicy_DUPLICATE_POLICY_OVERWRITE:
+		return util.DuplicatePolicyOverwrite, nil
+	default:
+		return "", fmt.Errorf("must be one of skip or overwrite")
+	}
+}
+
+func validateImportSnippetsRequest(req *pb.ImportSnippetsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetAccountId())); err != nil {
+		validations = append(validations, fieldValidation("account_id", err))
+	}
+
+	if _, err := importFormatFromPb(req.GetFormat()); err != nil {
+		validations = append(validations, fieldValidation("format", err))
+	}
+
+	if _, err := duplicatePolicyFromPb(req.GetDuplicatePolicy()); err != nil {
+		validations = append(validations, fieldValidation("duplicate_policy", err))
+	}
+
+	if err := validation.ValidateImportArchive(req.GetArchive()); err != nil {
+		validations = append(validations, fieldValidation("archive", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) InviteOrganizationMember(ctx context.Context, req *pb.InviteOrganizationMemberRequest) (*pb.InviteOrganizationMemberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateInviteOrganizationMemberRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	inviter, err := server.authorizeOrganization(ctx, req.GetOrganizationId(), authPayload.Name, util.OrgRoleMaintainer)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRole() == util.OrgRoleOwner && inviter.Role != util.OrgRoleOwner {
+		return nil, apperr.PermissionDenied("ORGANIZATION_ROLE_REQUIRED", "only owners can invite owners")
+	}
+
+	_, err = server.store.GetUser(ctx, req.GetInvitee())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("USER_NOT_FOUND", "invitee not found")
+		}
+		return nil, fmt.Errorf("failed to get invitee: %w", err)
+	}
+
+	_, err = server.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: req.GetOrganizationId(),
+		Username:       req.GetInvitee(),
+	})
+	if err == nil {
+		return nil, apperr.AlreadyExists("ORGANIZATION_MEMBER_EXISTS", "user is already a member of the organization")
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+
+	arg := db.CreateOrganizationInvitationParams{
+		OrganizationID: req.GetOrganizationId(),
+		Invitee:        req.GetInvitee(),
+		Role:           req.GetRole(),
+		InvitedBy:      authPayload.Name,
+		ExpiresAt:      time.Now().Add(organizationInvitationTTL),
+	}
+
+	invitation, err := server.store.CreateOrganizationInvitation(ctx, arg)
+	if err != nil {
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("ORGANIZATION_INVITATION_EXISTS", "user already has a pending invitation").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to create organization invitation: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditOrgMemberInvited, util.AuditTarget("organization", invitation.OrganizationID), map[string]interface{}{
+		"invitee": invitation.Invitee,
+		"role":    invitation.Role,
+	})
+
+	rsp := &pb.InviteOrganizationMemberResponse{
+		Invitation: convertOrganizationInvitation(invitation),
+	}
+
+	return rsp, nil
+}
+
+func validateInviteOrganizationMemberRequest(req *pb.InviteOrganizationMemberRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetOrganizationId()); err != nil {
+		validations = append(validations, fieldValidation("organization_id", err))
+	}
+
+	if err := validation.ValidateName(req.GetInvitee()); err != nil {
+		validations = append(validations, fieldValidation("invitee", err))
+	}
+
+	if err := validation.ValidateOrgRole(req.GetRole()); err != nil {
+		validations = append(validations, fieldValidation("role", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAuditEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the actions of the user and those on the user, like failed logins
+	arg := db.ListUserAuditEventsParams{
+		Actor:       authPayload.Name,
+		Target:      util.AuditTarget("user", authPayload.Name),
+		LimitCount:  req.GetPageSize(),
+		OffsetCount: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	events, err := server.store.ListUserAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	rsp := &pb.ListAuditEventsResponse{}
+	for _, event := range events {
+		rsp.Events = append(rsp.Events, convertAuditEvent(event))
+	}
+
+	return rsp, nil
+}
+
+func validateListAuditEventsRequest(req *pb.ListAuditEventsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
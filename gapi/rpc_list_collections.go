@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListCollectionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload.Name, util.OrgRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := server.store.ListCollections(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+
+	rsp := &pb.ListCollectionsResponse{}
+	for _, collection := range sortCollectionTree(collections) {
+		rsp.Collections = append(rsp.Collections, convertCollection(collection))
+	}
+
+	return rsp, nil
+}
+
+func validateListCollectionsRequest(req *pb.ListCollectionsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetAccountId())); err != nil {
+		validations = append(validations, fieldValidation("account_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+)
+
+func (server *Server) ListOrganizationInvitations(ctx context.Context, req *pb.ListOrganizationInvitationsRequest) (*pb.ListOrganizationInvitationsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := server.store.ListPendingInvitationsByInvitee(ctx, authPayload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization invitations: %w", err)
+	}
+
+	rsp := &pb.ListOrganizationInvitationsResponse{}
+	for _, invitation := range invitations {
+		rsp.Invitations = append(rsp.Invitations, convertOrganizationInvitation(invitation))
+	}
+
+	return rsp, nil
+}
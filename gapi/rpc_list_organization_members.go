@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListOrganizationMembers(ctx context.Context, req *pb.ListOrganizationMembersRequest) (*pb.ListOrganizationMembersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListOrganizationMembersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.authorizeOrganization(ctx, req.GetOrganizationId(), authPayload.Name, util.OrgRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := server.store.ListOrganizationMembers(ctx, req.GetOrganizationId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	rsp := &pb.ListOrganizationMembersResponse{}
+	for _, member := range members {
+		rsp.Members = append(rsp.Members, convertOrganizationMember(member))
+	}
+
+	return rsp, nil
+}
+
+func validateListOrganizationMembersRequest(req *pb.ListOrganizationMembersRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetOrganizationId()); err != nil {
+		validations = append(validations, fieldValidation("organization_id", err))
+	}
+
+	return validations
+}
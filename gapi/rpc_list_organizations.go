@@ -0,0 +1,34 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+)
+
+func (server *Server) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := server.store.ListOrganizationsByMember(ctx, authPayload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	rsp := &pb.ListOrganizationsResponse{}
+	for _, organization := range organizations {
+		rsp.Organizations = append(rsp.Organizations, convertOrganization(db.Organization{
+			ID:          organization.ID,
+			Name:        organization.Name,
+			DisplayName: organization.DisplayName,
+			Created:     organization.Created,
+		}, organization.Role))
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListSnippetComments(ctx context.Context, req *pb.ListSnippetCommentsRequest) (*pb.ListSnippetCommentsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListSnippetCommentsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListSnippetCommentsParams{
+		SnippetID: snippet.ID,
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	comments, err := server.store.ListSnippetComments(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	rsp := &pb.ListSnippetCommentsResponse{}
+	for _, comment := range comments {
+		rsp.Comments = append(rsp.Comments, convertSnippetComment(comment))
+	}
+
+	return rsp, nil
+}
+
+func validateListSnippetCommentsRequest(req *pb.ListSnippetCommentsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListSnippetForks(ctx context.Context, req *pb.ListSnippetForksRequest) (*pb.ListSnippetForksResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListSnippetForksRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	original, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	forkedFrom := sql.NullInt32{Int32: original.ID, Valid: true}
+
+	count, err := server.store.CountSnippetForks(ctx, forkedFrom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snippet forks: %w", err)
+	}
+
+	arg := db.ListSnippetForksParams{
+		ForkedFrom:  forkedFrom,
+		Username:    authPayload.Name,
+		LimitCount:  req.GetPageSize(),
+		OffsetCount: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	forks, err := server.store.ListSnippetForks(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snippet forks: %w", err)
+	}
+
+	rsp := &pb.ListSnippetForksResponse{
+		ForkCount: count,
+	}
+	for _, fork := range forks {
+		rsp.Forks = append(rsp.Forks, convertSnippet(fork))
+	}
+
+	return rsp, nil
+}
+
+func validateListSnippetForksRequest(req *pb.ListSnippetForksRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListStarredSnippets(ctx context.Context, req *pb.ListStarredSnippetsRequest) (*pb.ListStarredSnippetsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListStarredSnippetsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListStarredSnippetsParams{
+		Username:    authPayload.Name,
+		LimitCount:  req.GetPageSize(),
+		OffsetCount: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	snippets, err := server.store.ListStarredSnippets(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list starred snippets: %w", err)
+	}
+
+	rsp := &pb.ListStarredSnippetsResponse{}
+	for _, snippet := range snippets {
+		rsp.Snippets = append(rsp.Snippets, convertSnippet(snippet))
+	}
+
+	return rsp, nil
+}
+
+func validateListStarredSnippetsRequest(req *pb.ListStarredSnippetsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
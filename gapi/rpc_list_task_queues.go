@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+)
+
+func (server *Server) ListTaskQueues(ctx context.Context, req *pb.ListTaskQueuesRequest) (*pb.ListTaskQueuesResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	queues, err := server.taskInspector.ListQueues()
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	rsp := &pb.ListTaskQueuesResponse{
+		Queues: make([]*pb.TaskQueue, 0, len(queues)),
+	}
+	for _, queue := range queues {
+		rsp.Queues = append(rsp.Queues, convertTaskQueue(queue))
+	}
+
+	return rsp, nil
+}
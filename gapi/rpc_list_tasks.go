@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListTasksRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	state, _ := taskStateFromPb(req.GetState())
+	tasks, err := server.taskInspector.ListTasks(req.GetQueue(), state, int(req.GetPageId()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	rsp := &pb.ListTasksResponse{
+		Tasks: make([]*pb.Task, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		rsp.Tasks = append(rsp.Tasks, convertTask(task))
+	}
+
+	return rsp, nil
+}
+
+func taskStateFromPb(state pb.TaskState) (asynq.TaskState, error) {
+	switch state {
+	case pb.TaskState_TASK_STATE_PENDING:
+		return asynq.TaskStatePending, nil
+	case pb.TaskState_TASK_STATE_SCHEDULED:
+		return asynq.TaskStateScheduled, nil
+	case pb.TaskState_TASK_STATE_RETRY:
+		return asynq.TaskStateRetry, nil
+	case pb.TaskState_TASK_STATE_ARCHIVED:
+		return asynq.TaskStateArchived, nil
+	default:
+		return 0, fmt.Errorf("must be one of pending, scheduled, retry or archived")
+	}
+}
+
+func validateListTasksRequest(req *pb.ListTasksRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateQueueName(req.GetQueue()); err != nil {
+		validations = append(validations, fieldValidation("queue", err))
+	}
+
+	if _, err := taskStateFromPb(req.GetState()); err != nil {
+		validations = append(validations, fieldValidation("state", err))
+	}
+
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
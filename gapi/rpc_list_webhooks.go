@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+)
+
+func (server *Server) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := server.store.ListWebhooks(ctx, authPayload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	rsp := &pb.ListWebhooksResponse{}
+	for _, webhook := range webhooks {
+		rsp.Webhooks = append(rsp.Webhooks, convertWebhook(webhook))
+	}
+
+	return rsp, nil
+}
@@ -3,14 +3,14 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/pb"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/scipiia/snippetbox/validation"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,35 +24,45 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 	user, err := server.store.GetUser(ctx, req.GetName())
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, status.Errorf(codes.NotFound, "name not found")
+			// the name is chosen by the caller, it may be registered later
+			server.recordAuditEvent(ctx, util.AnonymousActor, util.AuditUserLoginFailed, "", map[string]interface{}{
+				"reason": "user_not_found",
+				"name":   req.GetName(),
+			})
+			return nil, apperr.NotFound("USER_NOT_FOUND", "name not found")
 			// ctx.JSON(http.StatusNotFound, errorResponse(err))
 			// return
 		}
-		return nil, status.Errorf(codes.Internal, "failed to find user")
+		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "incorrect password")
+		server.recordAuditEvent(ctx, util.AnonymousActor, util.AuditUserLoginFailed, util.AuditTarget("user", user.Name), map[string]interface{}{
+			"reason": "incorrect_password",
+		})
+		return nil, apperr.NotFound("INCORRECT_PASSWORD", "incorrect password")
 	}
 
 	//access token
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Name,
+		user.Role,
 		server.config.AccessTokenDuration,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create access token ")
+		return nil, fmt.Errorf("failed to create access token: %w", err)
 
 	}
 
 	//refresh token
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Name,
+		user.Role,
 		server.config.RefreshTokenDuration,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create refresh token")
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
 	}
 
 	//metadate for userAgent and ClientIP
@@ -69,9 +79,13 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		ExpiresAt:    refreshPayload.ExpiredAt,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create session")
+		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
+	server.recordAuditEvent(ctx, user.Name, util.AuditUserLogin, util.AuditTarget("user", user.Name), map[string]interface{}{
+		"session_id": session.ID.String(),
+	})
+
 	rsp := &pb.LoginUserResponse{
 		User:                  convertUser(user),
 		SessionId:             session.ID.String(),
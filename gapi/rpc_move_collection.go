@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) MoveCollection(ctx context.Context, req *pb.MoveCollectionRequest) (*pb.MoveCollectionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateMoveCollectionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	collection, err := server.authorizeCollection(ctx, req.GetId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.MoveCollectionTxParams{
+		ID:        collection.ID,
+		AccountID: collection.AccountID,
+		MaxDepth:  maxCollectionDepth,
+	}
+
+	if req.GetParentId() != 0 {
+		parent, err := server.getParentCollection(ctx, collection.AccountID, req.GetParentId())
+		if err != nil {
+			return nil, err
+		}
+		arg.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+
+	// the collection moves with its sub collections, the parent must not be
+	// one of them and the deepest one must stay within the limit
+	collection, err = server.store.MoveCollectionTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrCollectionCycle) {
+			return nil, apperr.FailedPrecondition("COLLECTION_CYCLE", "a collection cannot be moved into itself or one of its sub collections")
+		}
+		if errors.Is(err, db.ErrCollectionTooDeep) {
+			return nil, collectionTooDeepError()
+		}
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("COLLECTION_NOT_FOUND", "collection not found")
+		}
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("COLLECTION_ALREADY_EXISTS", "a collection with this name already exists here").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to move collection: %w", err)
+	}
+
+	rsp := &pb.MoveCollectionResponse{
+		Collection: convertCollection(collection),
+	}
+
+	return rsp, nil
+}
+
+func validateMoveCollectionRequest(req *pb.MoveCollectionRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	if req.GetParentId() != 0 {
+		if err := validation.ValidateID(req.GetParentId()); err != nil {
+			validations = append(validations, fieldValidation("parent_id", err))
+		}
+	}
+
+	return validations
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) PauseTaskQueue(ctx context.Context, req *pb.PauseTaskQueueRequest) (*pb.PauseTaskQueueResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validatePauseTaskQueueRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.taskInspector.PauseQueue(req.GetQueue())
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	return &pb.PauseTaskQueueResponse{}, nil
+}
+
+func validatePauseTaskQueueRequest(req *pb.PauseTaskQueueRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateQueueName(req.GetQueue()); err != nil {
+		validations = append(validations, fieldValidation("queue", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"github.com/scipiia/snippetbox/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRedeliverWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	webhook, err := server.getUserWebhook(ctx, authPayload.Name, req.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !webhook.IsActive {
+		return nil, apperr.FailedPrecondition("WEBHOOK_DISABLED", "webhook is disabled")
+	}
+
+	delivery, err := server.store.GetWebhookDelivery(ctx, req.GetDeliveryId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("WEBHOOK_DELIVERY_NOT_FOUND", "webhook delivery not found")
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	if delivery.WebhookID != webhook.ID {
+		return nil, apperr.NotFound("WEBHOOK_DELIVERY_NOT_FOUND", "webhook delivery not found")
+	}
+
+	taskPayload := &worker.PayloadDeliverWebhook{
+		WebhookID: webhook.ID,
+		Event:     delivery.Event,
+		Body:      delivery.Payload,
+	}
+
+	taskDistributor := worker.NewOutboxTaskDistributor(server.store)
+	err = worker.DistributeTaskDeliverWebhook(ctx, taskDistributor, taskPayload, worker.DeliverWebhookOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+
+	return &pb.RedeliverWebhookResponse{}, nil
+}
+
+func validateRedeliverWebhookRequest(req *pb.RedeliverWebhookRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetWebhookId()); err != nil {
+		validations = append(validations, fieldValidation("webhook_id", err))
+	}
+
+	if err := validation.ValidateID(req.GetDeliveryId()); err != nil {
+		validations = append(validations, fieldValidation("delivery_id", err))
+	}
+
+	return validations
+}
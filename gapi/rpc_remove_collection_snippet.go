@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RemoveCollectionSnippet(ctx context.Context, req *pb.RemoveCollectionSnippetRequest) (*pb.RemoveCollectionSnippetResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRemoveCollectionSnippetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	collection, err := server.authorizeCollection(ctx, req.GetCollectionId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.RemoveCollectionSnippet(ctx, db.RemoveCollectionSnippetParams{
+		CollectionID: collection.ID,
+		SnippetID:    req.GetSnippetId(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("COLLECTION_SNIPPET_NOT_FOUND", "snippet is not in the collection")
+		}
+		return nil, fmt.Errorf("failed to remove snippet from collection: %w", err)
+	}
+
+	return &pb.RemoveCollectionSnippetResponse{}, nil
+}
+
+func validateRemoveCollectionSnippetRequest(req *pb.RemoveCollectionSnippetRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetCollectionId()); err != nil {
+		validations = append(validations, fieldValidation("collection_id", err))
+	}
+
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	return validations
+}
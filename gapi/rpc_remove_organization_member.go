@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RemoveOrganizationMember(ctx context.Context, req *pb.RemoveOrganizationMemberRequest) (*pb.RemoveOrganizationMemberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRemoveOrganizationMemberRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// every member can leave, removing others needs a maintainer
+	required := util.OrgRoleMaintainer
+	if req.GetUsername() == authPayload.Name {
+		required = util.OrgRoleViewer
+	}
+
+	caller, err := server.authorizeOrganization(ctx, req.GetOrganizationId(), authPayload.Name, required)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := server.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: req.GetOrganizationId(),
+		Username:       req.GetUsername(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "organization member not found")
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+
+	if member.Role == util.OrgRoleOwner && caller.Role != util.OrgRoleOwner {
+		return nil, apperr.PermissionDenied("ORGANIZATION_ROLE_REQUIRED", "only owners can remove owners")
+	}
+
+	err = server.store.RemoveOrganizationMemberTx(ctx, db.DeleteOrganizationMemberParams{
+		OrganizationID: member.OrganizationID,
+		Username:       member.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrLastOrganizationOwner) {
+			return nil, lastOwnerError()
+		}
+		return nil, fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditOrgMemberRemoved, util.AuditTarget("organization", member.OrganizationID), map[string]interface{}{
+		"username": member.Username,
+		"role":     member.Role,
+	})
+
+	return &pb.RemoveOrganizationMemberResponse{}, nil
+}
+
+func validateRemoveOrganizationMemberRequest(req *pb.RemoveOrganizationMemberRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetOrganizationId()); err != nil {
+		validations = append(validations, fieldValidation("organization_id", err))
+	}
+
+	if err := validation.ValidateName(req.GetUsername()); err != nil {
+		validations = append(validations, fieldValidation("username", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RenameCollection(ctx context.Context, req *pb.RenameCollectionRequest) (*pb.RenameCollectionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRenameCollectionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.authorizeCollection(ctx, req.GetId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := server.store.RenameCollection(ctx, db.RenameCollectionParams{
+		Name: req.GetName(),
+		ID:   req.GetId(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("COLLECTION_NOT_FOUND", "collection not found")
+		}
+		if apperr.KindOf(err) == apperr.KindAlreadyExists {
+			return nil, apperr.AlreadyExists("COLLECTION_ALREADY_EXISTS", "a collection with this name already exists here").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to rename collection: %w", err)
+	}
+
+	rsp := &pb.RenameCollectionResponse{
+		Collection: convertCollection(collection),
+	}
+
+	return rsp, nil
+}
+
+func validateRenameCollectionRequest(req *pb.RenameCollectionRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	if err := validation.ValidateCollectionName(req.GetName()); err != nil {
+		validations = append(validations, fieldValidation("name", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ReorderCollectionSnippets(ctx context.Context, req *pb.ReorderCollectionSnippetsRequest) (*pb.ReorderCollectionSnippetsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateReorderCollectionSnippetsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	collection, err := server.authorizeCollection(ctx, req.GetCollectionId(), authPayload.Name, util.OrgRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.ReorderCollectionTx(ctx, db.ReorderCollectionTxParams{
+		CollectionID: collection.ID,
+		SnippetIDs:   req.GetSnippetIds(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrCollectionMembersMismatch) {
+			return nil, apperr.FailedPrecondition("COLLECTION_SNIPPETS_MISMATCH", "snippet_ids must list every snippet of the collection once").WithCause(err)
+		}
+		return nil, fmt.Errorf("failed to reorder collection: %w", err)
+	}
+
+	return &pb.ReorderCollectionSnippetsResponse{}, nil
+}
+
+func validateReorderCollectionSnippetsRequest(req *pb.ReorderCollectionSnippetsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetCollectionId()); err != nil {
+		validations = append(validations, fieldValidation("collection_id", err))
+	}
+
+	for _, id := range req.GetSnippetIds() {
+		if err := validation.ValidateID(int64(id)); err != nil {
+			validations = append(validations, fieldValidation("snippet_ids", err))
+			break
+		}
+	}
+
+	return validations
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/worker"
+)
+
+func (server *Server) RequestExport(ctx context.Context, req *pb.RequestExportRequest) (*pb.RequestExportResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateExportTxParams{
+		Owner: authPayload.Name,
+		AfterCreate: func(q db.Querier, export db.Export) error {
+			taskPayload := &worker.PayloadExportUserData{
+				ExportID: export.ID,
+			}
+
+			taskDistributor := worker.NewOutboxTaskDistributor(q)
+			return worker.DistributeTaskExportUserData(ctx, taskDistributor, taskPayload, worker.ExportUserDataOptions()...)
+		},
+	}
+
+	txResult, err := server.store.CreateExportTx(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export: %w", err)
+	}
+
+	rsp := &pb.RequestExportResponse{
+		Export: convertExport(txResult.Export),
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RespondOrganizationInvitation(ctx context.Context, req *pb.RespondOrganizationInvitationRequest) (*pb.RespondOrganizationInvitationResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRespondOrganizationInvitationRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	invitation, err := server.store.GetOrganizationInvitation(ctx, req.GetInvitationId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("INVITATION_NOT_FOUND", "invitation not found")
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	// invitations of other users are not revealed
+	if invitation.Invitee != authPayload.Name {
+		return nil, apperr.NotFound("INVITATION_NOT_FOUND", "invitation not found")
+	}
+	if invitation.Status != util.InvitationStatusPending {
+		return nil, apperr.FailedPrecondition("INVITATION_NOT_PENDING", "invitation was already answered")
+	}
+	if time.Now().After(invitation.ExpiresAt) {
+		return nil, apperr.FailedPrecondition("INVITATION_EXPIRED", "invitation has expired")
+	}
+
+	rsp := &pb.RespondOrganizationInvitationResponse{}
+
+	if !req.GetAccept() {
+		invitation, err = server.store.RespondOrganizationInvitation(ctx, db.RespondOrganizationInvitationParams{
+			Status: util.InvitationStatusDeclined,
+			ID:     invitation.ID,
+		})
+		if err != nil {
+			return nil, respondInvitationError(err)
+		}
+
+		rsp.Invitation = convertOrganizationInvitation(invitation)
+		return rsp, nil
+	}
+
+	txResult, err := server.store.AcceptOrganizationInvitationTx(ctx, invitation.ID)
+	if err != nil {
+		return nil, respondInvitationError(err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditOrgMemberJoined, util.AuditTarget("organization", txResult.Invitation.OrganizationID), map[string]interface{}{
+		"invitation_id": txResult.Invitation.ID,
+		"role":          txResult.Member.Role,
+	})
+
+	rsp.Invitation = convertOrganizationInvitation(txResult.Invitation)
+	rsp.Member = convertOrganizationMember(txResult.Member)
+	return rsp, nil
+}
+
+// the invitation is only updated while pending, no rows means it was
+// answered concurrently
+func respondInvitationError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperr.FailedPrecondition("INVITATION_NOT_PENDING", "invitation was already answered").WithCause(err)
+	}
+	return fmt.Errorf("failed to respond to invitation: %w", err)
+}
+
+func validateRespondOrganizationInvitationRequest(req *pb.RespondOrganizationInvitationRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetInvitationId()); err != nil {
+		validations = append(validations, fieldValidation("invitation_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ResumeTaskQueue(ctx context.Context, req *pb.ResumeTaskQueueRequest) (*pb.ResumeTaskQueueResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateResumeTaskQueueRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.taskInspector.ResumeQueue(req.GetQueue())
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	return &pb.ResumeTaskQueueResponse{}, nil
+}
+
+func validateResumeTaskQueueRequest(req *pb.ResumeTaskQueueRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateQueueName(req.GetQueue()); err != nil {
+		validations = append(validations, fieldValidation("queue", err))
+	}
+
+	return validations
+}
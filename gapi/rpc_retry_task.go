@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RetryTask(ctx context.Context, req *pb.RetryTaskRequest) (*pb.RetryTaskResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRetryTaskRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.taskInspector.RunTask(req.GetQueue(), req.GetId())
+	if err != nil {
+		return nil, taskInspectorError(err)
+	}
+
+	return &pb.RetryTaskResponse{}, nil
+}
+
+func validateRetryTaskRequest(req *pb.RetryTaskRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateQueueName(req.GetQueue()); err != nil {
+		validations = append(validations, fieldValidation("queue", err))
+	}
+
+	if err := validation.ValidateTaskID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	return validations
+}
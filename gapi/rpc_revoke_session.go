@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/scipiia/snippetbox/apperr"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeSessionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	sessionID := uuid.MustParse(req.GetSessionId())
+	session, err := server.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("SESSION_NOT_FOUND", "session not found")
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	if session.Name != authPayload.Name {
+		return nil, apperr.PermissionDenied("SESSION_NOT_OWNED", "session doesn't belong to the authenticated user")
+	}
+
+	if !session.IsBlocked {
+		_, err = server.store.BlockSession(ctx, sessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to block session: %w", err)
+		}
+
+		server.recordAuditEvent(ctx, authPayload.Name, util.AuditSessionRevoked, util.AuditTarget("session", session.ID), map[string]interface{}{
+			"user_agent": session.UserAgent,
+			"client_ip":  session.ClientIp,
+		})
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func validateRevokeSessionRequest(req *pb.RevokeSessionRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateSessionID(req.GetSessionId()); err != nil {
+		validations = append(validations, fieldValidation("session_id", err))
+	}
+
+	return validations
+}
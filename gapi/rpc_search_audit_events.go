@@ -0,0 +1,94 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) SearchAuditEvents(ctx context.Context, req *pb.SearchAuditEventsRequest) (*pb.SearchAuditEventsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole, util.ServiceRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSearchAuditEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.SearchAuditEventsParams{
+		Actor: sql.NullString{
+			String: req.GetActor(),
+			Valid:  req.Actor != nil,
+		},
+		Action: sql.NullString{
+			String: req.GetAction(),
+			Valid:  req.Action != nil,
+		},
+		Target: sql.NullString{
+			String: req.GetTarget(),
+			Valid:  req.Target != nil,
+		},
+		LimitCount:  req.GetPageSize(),
+		OffsetCount: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+	if req.Since != nil {
+		arg.Since = sql.NullTime{Time: req.GetSince().AsTime(), Valid: true}
+	}
+	if req.Until != nil {
+		arg.Until = sql.NullTime{Time: req.GetUntil().AsTime(), Valid: true}
+	}
+
+	events, err := server.store.SearchAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit events: %w", err)
+	}
+
+	rsp := &pb.SearchAuditEventsResponse{}
+	for _, event := range events {
+		rsp.Events = append(rsp.Events, convertAuditEvent(event))
+	}
+
+	return rsp, nil
+}
+
+func validateSearchAuditEventsRequest(req *pb.SearchAuditEventsRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if req.Actor != nil {
+		if err := validation.ValidateAuditFilter(req.GetActor()); err != nil {
+			validations = append(validations, fieldValidation("actor", err))
+		}
+	}
+
+	if req.Action != nil {
+		if err := validation.ValidateAuditFilter(req.GetAction()); err != nil {
+			validations = append(validations, fieldValidation("action", err))
+		}
+	}
+
+	if req.Target != nil {
+		if err := validation.ValidateAuditFilter(req.GetTarget()); err != nil {
+			validations = append(validations, fieldValidation("target", err))
+		}
+	}
+
+	if req.Since != nil && req.Until != nil && !req.GetUntil().AsTime().After(req.GetSince().AsTime()) {
+		validations = append(validations, fieldValidation("until", fmt.Errorf("must be after since")))
+	}
+
+	if err := validation.ValidatePageID(req.GetPageId()); err != nil {
+		validations = append(validations, fieldValidation("page_id", err))
+	}
+
+	if err := validation.ValidatePageSize(req.GetPageSize()); err != nil {
+		validations = append(validations, fieldValidation("page_size", err))
+	}
+
+	return validations
+}
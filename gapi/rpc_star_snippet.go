@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// StarSnippet stars a snippet the user can read, starring it again is a no-op.
+func (server *Server) StarSnippet(ctx context.Context, req *pb.StarSnippetRequest) (*pb.StarSnippetResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateStarSnippetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, req.GetSnippetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.StarSnippet(ctx, db.StarSnippetParams{
+		Username:  authPayload.Name,
+		SnippetID: snippet.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to star snippet: %w", err)
+	}
+
+	count, err := server.store.CountSnippetStars(ctx, snippet.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snippet stars: %w", err)
+	}
+
+	rsp := &pb.StarSnippetResponse{
+		StarCount: count,
+	}
+
+	return rsp, nil
+}
+
+func validateStarSnippetRequest(req *pb.StarSnippetRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// TransferAccount moves a personal account of the user into an organization
+// the user maintains, or an organization account the user owns to one of the
+// members of the organization.
+func (server *Server) TransferAccount(ctx context.Context, req *pb.TransferAccountRequest) (*pb.TransferAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateTransferAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload.Name, util.OrgRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferAccountParams{
+		ID: account.ID,
+	}
+
+	switch owner := req.GetOwner().(type) {
+	case *pb.TransferAccountRequest_OrganizationId:
+		if account.OrganizationID.Valid {
+			return nil, apperr.FailedPrecondition("ACCOUNT_NOT_PERSONAL", "only personal accounts can be moved into an organization")
+		}
+
+		_, err = server.authorizeOrganization(ctx, owner.OrganizationId, authPayload.Name, util.OrgRoleMaintainer)
+		if err != nil {
+			return nil, err
+		}
+
+		// the account keeps its creator
+		arg.Login = account.Login
+		arg.OrganizationID = sql.NullInt64{Int64: owner.OrganizationId, Valid: true}
+	case *pb.TransferAccountRequest_Username:
+		if !account.OrganizationID.Valid {
+			return nil, apperr.FailedPrecondition("ACCOUNT_NOT_SHARED", "only organization accounts can be moved to a member")
+		}
+
+		_, err = server.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+			OrganizationID: account.OrganizationID.Int64,
+			Username:       owner.Username,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, apperr.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "the new owner must be a member of the organization")
+			}
+			return nil, fmt.Errorf("failed to get organization member: %w", err)
+		}
+
+		arg.Login = owner.Username
+	}
+
+	transferred, err := server.store.TransferAccount(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("ACCOUNT_NOT_FOUND", "account not found")
+		}
+		return nil, fmt.Errorf("failed to transfer account: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditAccountTransferred, util.AuditTarget("account", account.ID), map[string]interface{}{
+		"from_login":           account.Login,
+		"from_organization_id": account.OrganizationID.Int64,
+		"to_login":             transferred.Login,
+		"to_organization_id":   transferred.OrganizationID.Int64,
+	})
+
+	rsp := &pb.TransferAccountResponse{
+		Account: convertAccount(transferred),
+	}
+
+	return rsp, nil
+}
+
+func validateTransferAccountRequest(req *pb.TransferAccountRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetAccountId())); err != nil {
+		validations = append(validations, fieldValidation("account_id", err))
+	}
+
+	switch owner := req.GetOwner().(type) {
+	case *pb.TransferAccountRequest_OrganizationId:
+		if err := validation.ValidateID(owner.OrganizationId); err != nil {
+			validations = append(validations, fieldValidation("organization_id", err))
+		}
+	case *pb.TransferAccountRequest_Username:
+		if err := validation.ValidateName(owner.Username); err != nil {
+			validations = append(validations, fieldValidation("username", err))
+		}
+	default:
+		validations = append(validations, &errdetails.BadRequest_FieldViolation{
+			Field:       "owner",
+			Description: "either organization_id or username is required",
+		})
+	}
+
+	return validations
+}
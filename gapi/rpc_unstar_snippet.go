@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// UnstarSnippet works without access to the snippet, so stars on snippets
+// the user can no longer read can be removed.
+func (server *Server) UnstarSnippet(ctx context.Context, req *pb.UnstarSnippetRequest) (*pb.UnstarSnippetResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUnstarSnippetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.store.UnstarSnippet(ctx, db.UnstarSnippetParams{
+		Username:  authPayload.Name,
+		SnippetID: req.GetSnippetId(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unstar snippet: %w", err)
+	}
+
+	count, err := server.store.CountSnippetStars(ctx, req.GetSnippetId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snippet stars: %w", err)
+	}
+
+	rsp := &pb.UnstarSnippetResponse{
+		StarCount: count,
+	}
+
+	return rsp, nil
+}
+
+func validateUnstarSnippetRequest(req *pb.UnstarSnippetRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(req.GetSnippetId())); err != nil {
+		validations = append(validations, fieldValidation("snippet_id", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) UpdateOrganizationMember(ctx context.Context, req *pb.UpdateOrganizationMemberRequest) (*pb.UpdateOrganizationMemberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateOrganizationMemberRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	caller, err := server.authorizeOrganization(ctx, req.GetOrganizationId(), authPayload.Name, util.OrgRoleMaintainer)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := server.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: req.GetOrganizationId(),
+		Username:       req.GetUsername(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "organization member not found")
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+
+	// maintainers manage the roles below owner
+	if (member.Role == util.OrgRoleOwner || req.GetRole() == util.OrgRoleOwner) && caller.Role != util.OrgRoleOwner {
+		return nil, apperr.PermissionDenied("ORGANIZATION_ROLE_REQUIRED", "only owners can grant or change the owner role")
+	}
+
+	member, err = server.store.UpdateOrganizationMemberRoleTx(ctx, db.UpdateOrganizationMemberRoleParams{
+		Role:           req.GetRole(),
+		OrganizationID: member.OrganizationID,
+		Username:       member.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrLastOrganizationOwner) {
+			return nil, lastOwnerError()
+		}
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "organization member not found")
+		}
+		return nil, fmt.Errorf("failed to update organization member: %w", err)
+	}
+
+	server.recordAuditEvent(ctx, authPayload.Name, util.AuditOrgMemberUpdated, util.AuditTarget("organization", member.OrganizationID), map[string]interface{}{
+		"username": member.Username,
+		"role":     member.Role,
+	})
+
+	rsp := &pb.UpdateOrganizationMemberResponse{
+		Member: convertOrganizationMember(member),
+	}
+
+	return rsp, nil
+}
+
+func validateUpdateOrganizationMemberRequest(req *pb.UpdateOrganizationMemberRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetOrganizationId()); err != nil {
+		validations = append(validations, fieldValidation("organization_id", err))
+	}
+
+	if err := validation.ValidateName(req.GetUsername()); err != nil {
+		validations = append(validations, fieldValidation("username", err))
+	}
+
+	if err := validation.ValidateOrgRole(req.GetRole()); err != nil {
+		validations = append(validations, fieldValidation("role", err))
+	}
+
+	return validations
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) UpdateSnippetComment(ctx context.Context, req *pb.UpdateSnippetCommentRequest) (*pb.UpdateSnippetCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateSnippetCommentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	comment, _, err := server.getReadableSnippetComment(ctx, req.GetId(), authPayload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Author != authPayload.Name {
+		return nil, apperr.PermissionDenied("COMMENT_AUTHOR_REQUIRED", "only the author can edit a comment")
+	}
+
+	comment, err = server.store.UpdateSnippetComment(ctx, db.UpdateSnippetCommentParams{
+		ID:   comment.ID,
+		Body: req.GetBody(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.FailedPrecondition("COMMENT_DELETED", "cannot edit a deleted comment")
+		}
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	rsp := &pb.UpdateSnippetCommentResponse{
+		Comment: convertSnippetComment(comment),
+	}
+
+	return rsp, nil
+}
+
+func validateUpdateSnippetCommentRequest(req *pb.UpdateSnippetCommentRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	if err := validation.ValidateCommentBody(req.GetBody()); err != nil {
+		validations = append(validations, fieldValidation("body", err))
+	}
+
+	return validations
+}
@@ -3,25 +3,25 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
+	"github.com/scipiia/snippetbox/apperr"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/pb"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/scipiia/snippetbox/validation"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx)
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
 	if err != nil {
-		return nil, unauthenticatedError(err)
+		return nil, err
 	}
 
 	if authPayload.Name != req.GetName() {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot upsate other user's info")
+		return nil, apperr.PermissionDenied("USER_NOT_OWNED", "cannot update other user's info")
 	}
 
 	violations := validateUpdateUserRequest(req)
@@ -59,7 +59,7 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	if req.Password != nil {
 		hashedPassword, err := util.HashedPassword(req.GetPassword())
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to hsah password: %s", err)
+			return nil, fmt.Errorf("failed to hash password: %w", err)
 		}
 
 		arg.HashedPassword = sql.NullString{
@@ -76,15 +76,33 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	user, err := server.store.UpdateUser(ctx, arg)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, status.Errorf(codes.NotFound, "user not found")
+			return nil, apperr.NotFound("USER_NOT_FOUND", "user not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to update user %s", err)
+		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
 	rsp := &pb.UpdateUserResponse{
 		User: convertUser(user),
 	}
 
+	var fields []string
+	if req.FullName != nil {
+		fields = append(fields, "full_name")
+	}
+	if req.Email != nil {
+		fields = append(fields, "email")
+	}
+	if len(fields) > 0 {
+		server.recordAuditEvent(ctx, authPayload.Name, util.AuditUserUpdated, util.AuditTarget("user", user.Name), map[string]interface{}{
+			"fields": fields,
+		})
+	}
+	if req.Password != nil {
+		server.recordAuditEvent(ctx, authPayload.Name, util.AuditUserPasswordChange, util.AuditTarget("user", user.Name), nil)
+	}
+
+	server.publishWebhookEvent(ctx, util.EventUserUpdated, user.Name, rsp.User)
+
 	return rsp, nil
 }
 
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.UserRole, util.AdminRole})
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getUserWebhook(ctx, authPayload.Name, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty events list keeps the current subscriptions
+	arg := db.UpdateWebhookParams{
+		Url: sql.NullString{
+			String: req.GetUrl(),
+			Valid:  req.Url != nil,
+		},
+		Events: req.GetEvents(),
+		Secret: sql.NullString{
+			String: req.GetSecret(),
+			Valid:  req.Secret != nil,
+		},
+		IsActive: sql.NullBool{
+			Bool:  req.GetIsActive(),
+			Valid: req.IsActive != nil,
+		},
+		ID: req.GetId(),
+	}
+
+	webhook, err := server.store.UpdateWebhook(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperr.NotFound("WEBHOOK_NOT_FOUND", "webhook not found")
+		}
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	rsp := &pb.UpdateWebhookResponse{
+		Webhook: convertWebhook(webhook),
+	}
+
+	return rsp, nil
+}
+
+func validateUpdateWebhookRequest(req *pb.UpdateWebhookRequest) (validations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(req.GetId()); err != nil {
+		validations = append(validations, fieldValidation("id", err))
+	}
+
+	if req.Url != nil {
+		if err := validation.ValidateWebhookURL(req.GetUrl()); err != nil {
+			validations = append(validations, fieldValidation("url", err))
+		}
+	}
+
+	if len(req.GetEvents()) > 0 {
+		if err := validation.ValidateWebhookEvents(req.GetEvents()); err != nil {
+			validations = append(validations, fieldValidation("events", err))
+		}
+	}
+
+	if req.Secret != nil {
+		if err := validation.ValidateWebhookSecret(req.GetSecret()); err != nil {
+			validations = append(validations, fieldValidation("secret", err))
+		}
+	}
+
+	return validations
+}
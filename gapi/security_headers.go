@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// the API only returns JSON, nothing may be loaded or framed
+	apiContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+	// swagger UI loads its own scripts, styles and inline images
+	swaggerContentSecurityPolicy = "default-src 'self'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; frame-ancestors 'none'"
+)
+
+// HttpSecurityHeaders sets the browser security headers of every response.
+// HSTS is only sent over TLS, as browsers ignore it on plain HTTP.
+func HttpSecurityHeaders(hstsMaxAge time.Duration, handler http.Handler) http.Handler {
+	hsts := "max-age=" + strconv.Itoa(int(hstsMaxAge.Seconds())) + "; includeSubDomains"
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		header := res.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("X-Frame-Options", "DENY")
+		header.Set("Referrer-Policy", "no-referrer")
+
+		if strings.HasPrefix(req.URL.Path, "/swagger/") {
+			header.Set("Content-Security-Policy", swaggerContentSecurityPolicy)
+		} else {
+			header.Set("Content-Security-Policy", apiContentSecurityPolicy)
+		}
+
+		if req.TLS != nil && hstsMaxAge > 0 {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+
+		handler.ServeHTTP(res, req)
+	})
+}
@@ -3,8 +3,10 @@ package gapi
 import (
 	"fmt"
 
+	"github.com/scipiia/snippetbox/blob"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/ratelimit"
 	"github.com/scipiia/snippetbox/token"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/scipiia/snippetbox/worker"
@@ -17,10 +19,21 @@ type Server struct {
 	tokenMaker token.Maker
 	pb.UnimplementedSnippetboxServer
 	taskDistributer worker.TaskDistributor
+	taskInspector   worker.TaskInspector
+	blobStore       blob.Store
+	urlSigner       *blob.URLSigner
+	rateLimiter     *ratelimit.Limiter
 }
 
 // *db.Queries change on db.Store mock db
-func NewServer(config util.Config, store db.Store, taskDistributer worker.TaskDistributor) (*Server, error) {
+func NewServer(
+	config util.Config,
+	store db.Store,
+	taskDistributer worker.TaskDistributor,
+	taskInspector worker.TaskInspector,
+	blobStore blob.Store,
+	rateLimiter *ratelimit.Limiter,
+) (*Server, error) {
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKye)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
@@ -30,6 +43,10 @@ func NewServer(config util.Config, store db.Store, taskDistributer worker.TaskDi
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributer: taskDistributer,
+		taskInspector:   taskInspector,
+		blobStore:       blobStore,
+		urlSigner:       blob.NewURLSigner(config.ExportURLSigningKey),
+		rateLimiter:     rateLimiter,
 	}
 
 	return server, nil
@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// SinglePortHandler serves gRPC and HTTP on one port, a gRPC call is an
+// HTTP/2 request with a gRPC content type. Plain text HTTP/2 (h2c) is
+// accepted too, so gRPC works without TLS.
+//
+// grpc.Server.GracefulStop and Stop must not be used with it: the transport
+// of grpc.Server.ServeHTTP cannot drain and panics. Drain waits for the calls
+// instead, h2c connections are hijacked so http.Server.Shutdown doesn't.
+type SinglePortHandler struct {
+	grpcServer  *grpc.Server
+	httpHandler http.Handler
+	handler     http.Handler
+
+	mu       sync.Mutex
+	draining bool
+	calls    sync.WaitGroup
+}
+
+func NewSinglePortHandler(grpcServer *grpc.Server, httpHandler http.Handler) *SinglePortHandler {
+	handler := &SinglePortHandler{
+		grpcServer:  grpcServer,
+		httpHandler: httpHandler,
+	}
+	handler.handler = h2c.NewHandler(http.HandlerFunc(handler.serve), &http2.Server{})
+	return handler
+}
+
+func (handler *SinglePortHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	handler.handler.ServeHTTP(res, req)
+}
+
+func (handler *SinglePortHandler) serve(res http.ResponseWriter, req *http.Request) {
+	if req.ProtoMajor != 2 || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+		handler.httpHandler.ServeHTTP(res, req)
+		return
+	}
+
+	if !handler.startCall() {
+		// a trailers-only response, clients may retry on another server
+		res.Header().Set("Content-Type", "application/grpc")
+		res.Header().Set("Grpc-Status", strconv.Itoa(int(codes.Unavailable)))
+		res.Header().Set("Grpc-Message", "server is shutting down")
+		res.WriteHeader(http.StatusOK)
+		return
+	}
+	defer handler.calls.Done()
+
+	handler.grpcServer.ServeHTTP(res, req)
+}
+
+// startCall counts a call unless the handler drains, the lock keeps Add from
+// racing with the Wait of Drain.
+func (handler *SinglePortHandler) startCall() bool {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	if handler.draining {
+		return false
+	}
+	handler.calls.Add(1)
+	return true
+}
+
+// Drain refuses new gRPC calls and waits until the running ones are done or
+// ctx is.
+func (handler *SinglePortHandler) Drain(ctx context.Context) error {
+	handler.mu.Lock()
+	handler.draining = true
+	handler.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		handler.calls.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
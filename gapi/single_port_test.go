@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scipiia/snippetbox/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// blockingServer holds LoginUser calls until release is closed
+type blockingServer struct {
+	pb.UnimplementedSnippetboxServer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (server *blockingServer) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+	server.started <- struct{}{}
+	<-server.release
+	return &pb.LoginUserResponse{}, nil
+}
+
+func newSinglePortTestServer(t *testing.T) (*SinglePortHandler, *blockingServer, pb.SnippetboxClient) {
+	fake := &blockingServer{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterSnippetboxServer(grpcServer, fake)
+
+	handler := NewSinglePortHandler(grpcServer, http.NotFoundHandler())
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+
+	conn, err := grpc.Dial(strings.TrimPrefix(httpServer.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return handler, fake, pb.NewSnippetboxClient(conn)
+}
+
+func TestSinglePortDrainWaitsForCalls(t *testing.T) {
+	handler, fake, client := newSinglePortTestServer(t)
+
+	callErr := make(chan error, 1)
+	go func() {
+		_, err := client.LoginUser(context.Background(), &pb.LoginUserRequest{})
+		callErr <- err
+	}()
+	<-fake.started
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- handler.Drain(context.Background())
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("drain returned with a running call")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// calls during the drain are refused
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := client.LoginUser(ctx, &pb.LoginUserRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	close(fake.release)
+	require.NoError(t, <-callErr)
+	require.NoError(t, <-drained)
+}
+
+func TestSinglePortDrainTimeout(t *testing.T) {
+	handler, fake, client := newSinglePortTestServer(t)
+	defer close(fake.release)
+
+	go client.LoginUser(context.Background(), &pb.LoginUserRequest{})
+	<-fake.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, handler.Drain(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,34 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+)
+
+// getReadableSnippet returns the snippet if it is public or the user can
+// read the snippets of its account.
+func (server *Server) getReadableSnippet(ctx context.Context, id int32, username string) (db.Snippet, error) {
+	snippet, err := server.store.GetSnippet(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return snippet, apperr.NotFound("SNIPPET_NOT_FOUND", "snippet not found")
+		}
+		return snippet, fmt.Errorf("failed to get snippet: %w", err)
+	}
+
+	if snippet.IsPublic {
+		return snippet, nil
+	}
+
+	_, err = server.authorizeAccount(ctx, snippet.AccountID, username, util.OrgRoleViewer)
+	if err != nil {
+		return snippet, err
+	}
+
+	return snippet, nil
+}
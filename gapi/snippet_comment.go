@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+// getReadableSnippetComment returns the comment and its snippet if the user
+// can read the snippet, see getReadableSnippet.
+func (server *Server) getReadableSnippetComment(ctx context.Context, id int64, username string) (db.SnippetComment, db.Snippet, error) {
+	comment, err := server.store.GetSnippetComment(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return comment, db.Snippet{}, apperr.NotFound("COMMENT_NOT_FOUND", "comment not found")
+		}
+		return comment, db.Snippet{}, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	snippet, err := server.getReadableSnippet(ctx, comment.SnippetID, username)
+	if err != nil {
+		return comment, snippet, err
+	}
+
+	return comment, snippet, nil
+}
+
+// snippetLineCount counts the lines of a file a comment can be anchored to, a
+// final newline doesn't start another line.
+func snippetLineCount(content string) int32 {
+	return int32(strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1)
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// probes and scrapes are not traced
+var untracedPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// HttpTracing runs every gateway request in a server span, which continues
+// the trace of a traceparent header. The gateway calls the gRPC handlers in
+// process, so their store calls and tasks join the same trace.
+func HttpTracing(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, "gateway",
+		otelhttp.WithFilter(func(req *http.Request) bool {
+			return !untracedPaths[req.URL.Path]
+		}),
+		otelhttp.WithSpanNameFormatter(func(operation string, req *http.Request) string {
+			return req.Method + " " + req.URL.Path
+		}),
+	)
+}
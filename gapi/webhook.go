@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/apperr"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/worker"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// getUserWebhook returns the webhook if it belongs to the user
+func (server *Server) getUserWebhook(ctx context.Context, owner string, id int64) (db.Webhook, error) {
+	webhook, err := server.store.GetWebhook(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return webhook, apperr.NotFound("WEBHOOK_NOT_FOUND", "webhook not found")
+		}
+		return webhook, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	if webhook.Owner != owner {
+		return webhook, apperr.PermissionDenied("WEBHOOK_NOT_OWNED", "webhook doesn't belong to the authenticated user")
+	}
+
+	return webhook, nil
+}
+
+// publishWebhookEvent queues the event for the webhooks of the owner. The
+// change has already been stored, so a failure is only logged.
+func (server *Server) publishWebhookEvent(ctx context.Context, event string, owner string, data proto.Message) {
+	jsonData, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(data)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("event", event).Msg("cannot marshal webhook event")
+		return
+	}
+
+	payload := &worker.PayloadDispatchWebhookEvent{
+		Event:      event,
+		Owner:      owner,
+		Data:       jsonData,
+		OccurredAt: time.Now(),
+	}
+
+	taskDistributor := worker.NewOutboxTaskDistributor(server.store)
+	err = worker.DistributeTaskDispatchWebhookEvent(ctx, taskDistributor, payload)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("event", event).Msg("cannot publish webhook event")
+	}
+}
@@ -0,0 +1,161 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+
+	// a dependency which doesn't answer in time is down
+	checkTimeout = 2 * time.Second
+)
+
+// Check returns an error when the dependency is not usable.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// CheckResult is the status of one dependency.
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the body of the readiness endpoint.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Checker decides whether the process is ready to serve requests.
+type Checker struct {
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Add registers a dependency, it must be called before the checker is used.
+func (checker *Checker) Add(name string, check Check) {
+	checker.checks = append(checker.checks, namedCheck{
+		name:  name,
+		check: check,
+	})
+}
+
+// Shutdown makes the process not ready, so it gets no new traffic while the
+// servers drain.
+func (checker *Checker) Shutdown() {
+	checker.shuttingDown.Store(true)
+}
+
+// Check runs all checks concurrently.
+func (checker *Checker) Check(ctx context.Context) Report {
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]CheckResult, len(checker.checks)),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range checker.checks {
+		wg.Add(1)
+		go func(c namedCheck) {
+			defer wg.Done()
+
+			result := CheckResult{Status: StatusUp}
+			if err := c.check(ctx); err != nil {
+				result = CheckResult{Status: StatusDown, Error: err.Error()}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[c.name] = result
+			if result.Status == StatusDown {
+				report.Status = StatusDown
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	if checker.shuttingDown.Load() {
+		report.Status = StatusDown
+		report.Checks["shutdown"] = CheckResult{Status: StatusDown, Error: "server is shutting down"}
+	}
+
+	return report
+}
+
+// Live answers the liveness probe, the process is alive as long as it serves
+// HTTP, dependencies are left to the readiness probe.
+func (checker *Checker) Live(res http.ResponseWriter, req *http.Request) {
+	writeJSON(res, http.StatusOK, map[string]string{"status": StatusUp})
+}
+
+// Ready answers the readiness probe with the status of every dependency.
+func (checker *Checker) Ready(res http.ResponseWriter, req *http.Request) {
+	report := checker.Check(req.Context())
+
+	code := http.StatusOK
+	if report.Status != StatusUp {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(res, code, report)
+}
+
+func writeJSON(res http.ResponseWriter, code int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.Header().Set("Cache-Control", "no-store")
+	res.WriteHeader(code)
+	if err := json.NewEncoder(res).Encode(body); err != nil {
+		log.Error().Err(err).Msg("failed to write health response")
+	}
+}
+
+// Watch keeps the status of the gRPC health server in line with readiness
+// for the given services, "" is the status of the whole server. On shutdown
+// every service turns NOT_SERVING.
+func (checker *Checker) Watch(ctx context.Context, server *health.Server, interval time.Duration, services ...string) {
+	services = append([]string{""}, services...)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if report := checker.Check(ctx); report.Status != StatusUp {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			checker.Shutdown()
+			server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
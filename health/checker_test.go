@@ -0,0 +1,104 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReady(t *testing.T) {
+	testCases := []struct {
+		name          string
+		checks        map[string]Check
+		shutdown      bool
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return nil },
+				"redis":    func(ctx context.Context) error { return nil },
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				report := decodeReport(t, recorder)
+				require.Equal(t, StatusUp, report.Status)
+				require.Len(t, report.Checks, 2)
+				require.Equal(t, StatusUp, report.Checks["database"].Status)
+			},
+		},
+		{
+			name: "DependencyDown",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return nil },
+				"redis":    func(ctx context.Context) error { return errors.New("connection refused") },
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+				report := decodeReport(t, recorder)
+				require.Equal(t, StatusDown, report.Status)
+				require.Equal(t, StatusUp, report.Checks["database"].Status)
+				require.Equal(t, StatusDown, report.Checks["redis"].Status)
+				require.Equal(t, "connection refused", report.Checks["redis"].Error)
+			},
+		},
+		{
+			name: "ShuttingDown",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return nil },
+			},
+			shutdown: true,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+				report := decodeReport(t, recorder)
+				require.Equal(t, StatusDown, report.Status)
+				require.Equal(t, StatusDown, report.Checks["shutdown"].Status)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker()
+			for name, check := range tc.checks {
+				checker.Add(name, check)
+			}
+			if tc.shutdown {
+				checker.Shutdown()
+			}
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			checker.Ready(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestLive(t *testing.T) {
+	checker := NewChecker()
+	checker.Add("database", func(ctx context.Context) error { return errors.New("down") })
+	checker.Shutdown()
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	checker.Live(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func decodeReport(t *testing.T, recorder *httptest.ResponseRecorder) Report {
+	var report Report
+	err := json.NewDecoder(recorder.Body).Decode(&report)
+	require.NoError(t, err)
+	return report
+}
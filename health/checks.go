@@ -0,0 +1,78 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/scipiia/snippetbox/db/migration"
+	"github.com/scipiia/snippetbox/worker"
+)
+
+// DatabaseCheck pings the database.
+func DatabaseCheck(conn *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return conn.PingContext(ctx)
+	}
+}
+
+// RedisCheck pings the Redis server of the task broker.
+func RedisCheck(redisOpt asynq.RedisClientOpt) Check {
+	client := redisOpt.MakeRedisClient().(redis.UniversalClient)
+
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+// MigrationCheck compares the version applied to the database with the
+// latest migration of the source, a dirty or older schema is not ready.
+func MigrationCheck(conn *sql.DB, migrationURL string) (Check, error) {
+	versions, err := migration.Versions(migrationURL)
+	if err != nil {
+		return nil, err
+	}
+	var latest uint
+	if len(versions) > 0 {
+		latest = versions[len(versions)-1]
+	}
+
+	return func(ctx context.Context) error {
+		var version uint
+		var dirty bool
+		err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("no migration applied, want version %d", latest)
+			}
+			return fmt.Errorf("failed to get migration version: %w", err)
+		}
+
+		if dirty {
+			return fmt.Errorf("migration %d is dirty", version)
+		}
+		if version != latest {
+			return fmt.Errorf("migration version is %d, want %d", version, latest)
+		}
+
+		return nil
+	}, nil
+}
+
+// WorkerCheck requires at least one task processor with a recent heartbeat.
+func WorkerCheck(taskInspector worker.TaskInspector) Check {
+	return func(ctx context.Context) error {
+		workers, err := taskInspector.ListWorkers()
+		if err != nil {
+			return fmt.Errorf("failed to list workers: %w", err)
+		}
+
+		if len(workers) == 0 {
+			return fmt.Errorf("no worker heartbeat")
+		}
+
+		return nil
+	}
+}
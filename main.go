@@ -3,27 +3,45 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file" //github меняем на file
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
 	_ "github.com/lib/pq"
 	"github.com/rakyll/statik/fs"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/scipiia/snippetbox/api"
+	"github.com/scipiia/snippetbox/blob"
+	"github.com/scipiia/snippetbox/db/migration"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 	_ "github.com/scipiia/snippetbox/doc/statik"
 	"github.com/scipiia/snippetbox/gapi"
+	"github.com/scipiia/snippetbox/health"
+	"github.com/scipiia/snippetbox/metrics"
 	"github.com/scipiia/snippetbox/pb"
+	"github.com/scipiia/snippetbox/ratelimit"
+	"github.com/scipiia/snippetbox/tlsconfig"
+	"github.com/scipiia/snippetbox/tracing"
 	"github.com/scipiia/snippetbox/util"
 	"github.com/scipiia/snippetbox/worker"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -34,8 +52,44 @@ import (
 // 	serverAdress = "0.0.0.0:8080"
 // )
 
+// run modes, the first command line argument, "all" when omitted
+const (
+	modeAll         = "all"
+	modeServeAPI    = "serve-api"
+	modeServeWorker = "serve-worker"
+	modeMigrate     = "migrate"
+	modeConfig      = "config"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// how often the gRPC health status follows readiness
+const healthWatchInterval = 5 * time.Second
+
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+	syscall.SIGINT,
+}
+
 func main() {
-	config, err := util.LiadConfig(".")
+	// snippetbox [mode] [command args] [flags], the flags override the config
+	args := os.Args[1:]
+	mode := modeAll
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		mode, args = args[0], args[1:]
+	}
+
+	var commandArgs []string
+	for len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		commandArgs, args = append(commandArgs, args[0]), args[1:]
+	}
+
+	if mode == modeConfig && (len(commandArgs) != 1 || commandArgs[0] != "print") {
+		log.Fatal().Msgf("unknown config command, use: %s print", modeConfig)
+	}
+
+	config, err := util.LoadConfig(".", args)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot load config:")
 	}
@@ -43,6 +97,38 @@ func main() {
 	if config.Environment == "development" {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
+	// log.Ctx falls back to the global logger outside of requests and tasks
+	zerolog.DefaultContextLogger = &log.Logger
+
+	switch mode {
+	case modeConfig:
+		runConfigPrint(config)
+		return
+	case modeMigrate:
+		runMigrateCommand(config, commandArgs)
+		return
+	case modeAll, modeServeAPI, modeServeWorker:
+		if len(commandArgs) > 0 {
+			log.Fatal().Msgf("unexpected arguments %v for mode %s", commandArgs, mode)
+		}
+	default:
+		log.Fatal().Msgf("unknown mode %q, use one of: %s, %s, %s, %s, %s", mode, modeAll, modeServeAPI, modeServeWorker, modeMigrate, modeConfig)
+	}
+
+	validateConfig(config)
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:     config.TraceExporter,
+		OTLPEndpoint: config.OTLPEndpoint,
+		OTLPInsecure: config.OTLPInsecure,
+		SampleRatio:  config.TraceSampleRatio,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot set up tracing")
+	}
 
 	conn, err := sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
@@ -50,80 +136,467 @@ func main() {
 	}
 
 	//run db migraton
-	runDBMigration(config.MigrationURL, config.DBSource)
+	if mode == modeAll && config.AutoMigrate {
+		runDBMigration(ctx, config)
+	}
 
 	//conn to db
 	//store := db.New(conn)
-	store := db.NewStore(conn)
+	store := db.NewObservedStore(db.NewStore(conn), metrics.ObserveStore, tracing.ObserveStore)
 
-	//redis
-	redisOpt := asynq.RedisClientOpt{
-		Addr: config.RedisAddress,
+	err = metrics.RegisterDB(conn, config.DBDriver)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register db metrics")
 	}
 
-	taskDistributer := worker.NewRedisTaskDistributor(redisOpt)
-	go runTaskProcessor(redisOpt, store)
+	blobStore, err := blob.NewLocalStore(config.BlobStorageDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create blob store")
+	}
+
+	var taskDistributer worker.TaskDistributor
+	var taskInspector worker.TaskInspector
+	var taskProcessor worker.TaskProcessor
+	// rate limits are shared through Redis when there is one
+	var rateLimitStore ratelimit.Store = ratelimit.NewMemoryStore()
+
+	healthChecker := health.NewChecker()
+	healthChecker.Add("database", health.DatabaseCheck(conn))
+
+	switch config.TaskBroker {
+	case worker.BrokerRedis:
+		//redis
+		redisOpt := asynq.RedisClientOpt{
+			Addr: config.RedisAddress,
+		}
+
+		taskDistributer = worker.NewRedisTaskDistributor(redisOpt)
+		taskInspector = worker.NewRedisTaskInspector(redisOpt)
+		healthChecker.Add("redis", health.RedisCheck(redisOpt))
+		rateLimitStore = ratelimit.NewRedisStore(redisOpt.MakeRedisClient().(redis.UniversalClient), rateLimitStore)
+		taskProcessor = worker.NewRedisTaskProcessor(redisOpt, store, taskDistributer, blobStore, config.ExportRetention)
+	case worker.BrokerMemory:
+		// tasks live in this process, the API and the worker can't be split
+		if mode != modeAll {
+			log.Fatal().Msgf("task broker %q requires mode %q", config.TaskBroker, modeAll)
+		}
+
+		log.Warn().Msg("memory task broker: queued and retrying tasks are lost on restart")
+		broker := worker.NewMemoryBroker()
+		taskDistributer = worker.NewMemoryTaskDistributor(broker)
+		taskInspector = worker.NewMemoryTaskInspector(broker)
+		taskProcessor = worker.NewMemoryTaskProcessor(broker, store, taskDistributer, blobStore, config.ExportRetention)
+	default:
+		log.Fatal().Msgf("unknown task broker %q, use one of: %s, %s", config.TaskBroker, worker.BrokerRedis, worker.BrokerMemory)
+	}
+
+	migrationCheck, err := health.MigrationCheck(conn, config.MigrationURL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create migration health check")
+	}
+	healthChecker.Add("migrations", migrationCheck)
+	healthChecker.Add("worker", health.WorkerCheck(taskInspector))
+
+	rateLimiter, err := newRateLimiter(config, rateLimitStore)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create rate limiter")
+	}
+
+	tlsReloader, err := newTLSReloader(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load TLS certificates")
+	}
+
+	// the first component to fail cancels ctx and the others shut down
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	if mode == modeAll || mode == modeServeWorker {
+		runTaskProcessor(ctx, waitGroup, taskProcessor)
+		runOutboxRelay(ctx, waitGroup, config, store, taskDistributer)
+		runExportPurger(ctx, waitGroup, config, store, blobStore)
+	}
+
+	// the gateway serves /metrics, a worker on its own needs a server for it
+	if mode == modeServeWorker {
+		runMetricsServer(ctx, waitGroup, config)
+	}
+
+	if mode == modeAll || mode == modeServeAPI {
+		server, err := gapi.NewServer(config, store, taskDistributer, taskInspector, blobStore, rateLimiter)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot create server")
+		}
+
+		if config.SinglePort {
+			runSinglePortServer(ctx, waitGroup, config, server, healthChecker, tlsReloader)
+		} else {
+			runGrpcServer(ctx, waitGroup, config, server, healthChecker, tlsReloader)
+			runGatewayServer(ctx, waitGroup, config, server, healthChecker, tlsReloader)
+		}
+		//runGinServer(config, query)
+	}
 
-	go runGrpcServer(config, store, taskDistributer)
-	runGatewayServer(config, store, taskDistributer)
-	//runGinServer(config, query)
+	err = waitGroup.Wait()
+
+	// flush the spans of the shutdown
+	flushCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		log.Error().Err(err).Msg("failed to flush traces")
+	}
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("error from wait group")
+	}
+}
+
+// validateConfig stops with every problem of the config at once
+func validateConfig(config util.Config) {
+	err := config.Validate()
+	if err == nil {
+		return
+	}
+
+	var configErr *util.ConfigError
+	if errors.As(err, &configErr) {
+		log.Fatal().Strs("problems", configErr.Problems).Msg("invalid config")
+	}
+	log.Fatal().Err(err).Msg("invalid config")
+}
+
+// config print shows the effective config without secrets and its problems
+func runConfigPrint(config util.Config) {
+	err := config.Print(os.Stdout)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot print config")
+	}
+
+	var configErr *util.ConfigError
+	if errors.As(config.Validate(), &configErr) {
+		for _, problem := range configErr.Problems {
+			fmt.Fprintln(os.Stderr, "problem:", problem)
+		}
+		os.Exit(1)
+	}
 }
 
 // migration
-func runDBMigration(migrationURL string, dbSource string) {
-	migration, err := migrate.New(migrationURL, dbSource)
+func runDBMigration(ctx context.Context, config util.Config) {
+	migrator, err := migration.New(ctx, config.DBSource, config.MigrationURL)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create a new migrate instance")
 	}
+	defer migrator.Close()
 
-	if err := migration.Up(); err != nil && err != migrate.ErrNoChange {
+	if err := migrator.Up(0); err != nil {
 		log.Fatal().Err(err).Msg("failed to run migrate up")
 	}
 
 	log.Info().Msg("db migrate successfully")
 }
 
-// run processor redis
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) {
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store)
+// migrate [up [N] | down [N] | status | force V | create NAME], up by default
+func runMigrateCommand(config util.Config, args []string) {
+	command := "up"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	if command == "create" {
+		if len(args) != 1 {
+			log.Fatal().Msg("usage: migrate create NAME")
+		}
+		up, down, err := migration.Create(migration.Dir, args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot create migration")
+		}
+		fmt.Println(up)
+		fmt.Println(down)
+		return
+	}
+
+	switch command {
+	case "up", "down", "status", "force":
+	default:
+		log.Fatal().Msgf("unknown migrate command %q, use one of: up, down, status, force, create", command)
+	}
+
+	// at most one number, like the version of force
+	number := 0
+	if len(args) > 1 {
+		log.Fatal().Msgf("too many arguments for migrate %s", command)
+	}
+	if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 0 {
+			log.Fatal().Msgf("invalid number %q for migrate %s", args[0], command)
+		}
+		number = n
+	}
+	if command == "force" && len(args) != 1 {
+		log.Fatal().Msg("usage: migrate force V")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	migrator, err := migration.New(ctx, config.DBSource, config.MigrationURL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create a new migrate instance")
+	}
+	defer migrator.Close()
+
+	switch command {
+	case "up":
+		err = migrator.Up(number)
+	case "down":
+		// one step unless more are asked for, rolling back everything drops all data
+		if number == 0 {
+			number = 1
+		}
+		err = migrator.Down(number)
+	case "force":
+		err = migrator.Force(number)
+	case "status":
+		var status migration.Status
+		status, err = migrator.Status(config.MigrationURL)
+		if err == nil {
+			fmt.Printf("version: %d\ndirty: %t\nlatest: %d\npending: %v\n", status.Version, status.Dirty, status.Latest, status.Pending)
+		}
+	}
+	if err != nil {
+		// defers do not run on log.Fatal, the lock is released with the connection
+		log.Fatal().Err(err).Msgf("failed to run migrate %s", command)
+	}
+
+	log.Info().Msgf("migrate %s done", command)
+}
+
+// run task processor
+func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, taskProcessor worker.TaskProcessor) {
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to start task processor")
 	}
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown task processor")
+
+		taskProcessor.Shutdown()
+		log.Info().Msg("task processor is stopped")
+
+		return nil
+	})
 }
 
-// gRPC server
-func runGrpcServer(config util.Config, store db.Store, taskDistributer worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributer)
+// relay outbox tasks to redis
+func runOutboxRelay(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributer worker.TaskDistributor) {
+	relay := worker.NewOutboxRelay(store, taskDistributer, config.OutboxRelayInterval, config.OutboxRetention)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("start outbox relay")
+		err := relay.Start(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("outbox relay failed")
+			return err
+		}
+
+		log.Info().Msg("outbox relay is stopped")
+		return nil
+	})
+}
+
+// rate limits from the config, empty limits are disabled
+func newRateLimiter(config util.Config, store ratelimit.Store) (*ratelimit.Limiter, error) {
+	var policy ratelimit.Policy
+	var err error
+
+	policy.User, err = ratelimit.ParseLimit(config.RateLimitUser)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
+		return nil, err
+	}
+	policy.IP, err = ratelimit.ParseLimit(config.RateLimitIP)
+	if err != nil {
+		return nil, err
+	}
+	policy.Methods, err = ratelimit.ParseMethodLimits(config.RateLimitMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	return ratelimit.NewLimiter(store, policy), nil
+}
+
+// TLS of the gRPC and HTTP listeners, nil serves plain TCP
+func newTLSReloader(config util.Config) (*tlsconfig.Reloader, error) {
+	opts := tlsconfig.Options{
+		CertFile:          config.TLSCertFile,
+		KeyFile:           config.TLSKeyFile,
+		ClientCAFile:      config.TLSClientCAFile,
+		RequireClientCert: config.TLSRequireClientCert,
+		MinVersion:        config.TLSMinVersion,
+	}
+	if !opts.Enabled() {
+		return nil, nil
+	}
+
+	return tlsconfig.NewReloader(opts)
+}
+
+// delete export archives after the retention period
+func runExportPurger(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, blobStore blob.Store) {
+	purger := worker.NewExportPurger(store, blobStore, config.ExportPurgeInterval)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("start export purger")
+		err := purger.Start(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("export purger failed")
+			return err
+		}
+
+		log.Info().Msg("export purger is stopped")
+		return nil
+	})
+}
+
+// metrics HTTP server
+func runMetricsServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	httpServer := &http.Server{
+		Handler: mux,
+		Addr:    config.MetricsServerAddress,
 	}
 
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start metrics server at %s", httpServer.Addr)
+		err := httpServer.ListenAndServe()
+		if err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			log.Error().Err(err).Msg("metrics server failed to serve")
+			return err
+		}
+
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		err := httpServer.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to shutdown metrics server")
+			return err
+		}
+
+		log.Info().Msg("metrics server is stopped")
+		return nil
+	})
+}
+
+// gRPC server with the interceptors, its health server reports readiness
+func newGrpcServer(server *gapi.Server, options ...grpc.ServerOption) (*grpc.Server, *grpchealth.Server) {
 	//logger
-	grpcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(grpcLogger)
+	grpcLogger := grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		gapi.GrpcMetrics,
+		gapi.GrpcLogger,
+		server.GrpcRateLimiter,
+		gapi.GrpcErrors,
+	)
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpcLogger}, options...)...)
 	pb.RegisterSnippetboxServer(grpcServer, server)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
+
+	return grpcServer, healthServer
+}
+
+// gRPC server
+func runGrpcServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	server *gapi.Server,
+	healthChecker *health.Checker,
+	tlsReloader *tlsconfig.Reloader,
+) {
+	var options []grpc.ServerOption
+	if tlsReloader != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsReloader.ServerConfig("h2"))))
+	}
+	grpcServer, healthServer := newGrpcServer(server, options...)
+
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create listener")
 	}
 
-	log.Info().Msgf("start gRPC server at %s", listener.Addr().String())
-	err = grpcServer.Serve(listener)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start gRPC server")
-	}
-}
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start gRPC server at %s", listener.Addr().String())
+		err := grpcServer.Serve(listener)
+		if err != nil {
+			if errors.Is(err, grpc.ErrServerStopped) {
+				return nil
+			}
+			log.Error().Err(err).Msg("gRPC server failed to serve")
+			return err
+		}
 
-// Gateway HTTP server
-func runGatewayServer(config util.Config, store db.Store, taskDistributer worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributer)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
-	}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		// turns NOT_SERVING once ctx is done
+		healthChecker.Watch(ctx, healthServer, healthWatchInterval, pb.Snippetbox_ServiceDesc.ServiceName)
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown gRPC server")
+
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		// calls still running after the timeout are cancelled
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			log.Warn().Msg("gRPC server did not stop in time, closing open connections")
+			grpcServer.Stop()
+			<-stopped
+		}
+		log.Info().Msg("gRPC server is stopped")
 
+		return nil
+	})
+}
+
+// newGatewayHandler serves the REST gateway, swagger, probes and metrics.
+// grpcAddress is where the gateway dials the gRPC server when it does not
+// call the handlers in process.
+func newGatewayHandler(
+	ctx context.Context,
+	config util.Config,
+	server *gapi.Server,
+	healthChecker *health.Checker,
+	tlsReloader *tlsconfig.Reloader,
+	grpcAddress string,
+) http.Handler {
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			UseProtoNames: true,
@@ -133,17 +606,41 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributer worker
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	grpcMux := runtime.NewServeMux(
+		jsonOption,
+		runtime.WithErrorHandler(gapi.HttpErrorHandler),
+		runtime.WithForwardResponseOption(gapi.AuthCookieResponse(config.AuthCookieName, tlsReloader != nil)),
+		runtime.WithMetadata(gapi.GatewayMetadata),
+	)
 
-	err = pb.RegisterSnippetboxHandlerServer(ctx, grpcMux, server)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot register handler server")
+	// the gRPC interceptors limit the calls of a gateway client connection
+	gatewayHandler := server.HttpRateLimiter(grpcMux)
+
+	if config.GatewayGRPCClient {
+		conn, err := dialGrpcServer(ctx, config, grpcAddress, tlsReloader)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot dial gRPC server")
+		}
+
+		err = pb.RegisterSnippetboxHandler(ctx, grpcMux, conn)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot register handler client")
+		}
+		gatewayHandler = grpcMux
+	} else {
+		err := pb.RegisterSnippetboxHandlerServer(ctx, grpcMux, server)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot register handler server")
+		}
 	}
 
 	mux := http.NewServeMux()
-	mux.Handle("/", grpcMux)
+	mux.Handle("/", gapi.HttpMetrics("gateway", gatewayHandler))
+	mux.Handle(gapi.ExportDownloadPath, gapi.HttpMetrics("export_download", server.HttpRateLimiter(http.HandlerFunc(server.DownloadExport))))
+	mux.Handle(gapi.AuditEventsExportPath, gapi.HttpMetrics("audit_export", server.HttpRateLimiter(http.HandlerFunc(server.ExportAuditEvents))))
+	mux.HandleFunc("/healthz", healthChecker.Live)
+	mux.HandleFunc("/readyz", healthChecker.Ready)
+	mux.Handle("/metrics", metrics.Handler())
 
 	//static swagger files
 	//fs := http.FileServer(http.Dir("./doc/swagger"))
@@ -153,20 +650,154 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributer worker
 	}
 
 	swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(statikFs))
-	mux.Handle("/swagger/", swaggerHandler)
+	mux.Handle("/swagger/", gapi.HttpMetrics("swagger", swaggerHandler))
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create HTTP gateway listener")
+	corsOptions := gapi.CORSOptions{
+		AllowedOrigins:   config.CORSAllowedOrigins,
+		AllowedMethods:   config.CORSAllowedMethods,
+		AllowedHeaders:   config.CORSAllowedHeaders,
+		AllowCredentials: config.CORSAllowCredentials,
+		MaxAge:           config.CORSMaxAge,
 	}
 
-	log.Info().Msgf("start HTTP  gateway server at %s", listener.Addr().String())
 	//logger
-	handler := gapi.HttpLogger(mux)
-	err = http.Serve(listener, handler)
+	return gapi.HttpSecurityHeaders(config.HSTSMaxAge,
+		gapi.HttpCORS(corsOptions,
+			gapi.HttpCookieAuth(config.AuthCookieName, tlsReloader != nil,
+				gapi.HttpLogger(gapi.HttpTracing(gapi.HttpClientIdentity(mux))))))
+}
+
+// dialGrpcServer connects the gateway to the gRPC server of this process,
+// with TLS it trusts exactly the loaded server certificate.
+func dialGrpcServer(ctx context.Context, config util.Config, address string, tlsReloader *tlsconfig.Reloader) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if tlsReloader != nil {
+		if config.TLSRequireClientCert {
+			return nil, errors.New("the gateway has no client certificate, it cannot dial when TLS_REQUIRE_CLIENT_CERT is set")
+		}
+		creds = credentials.NewTLS(tlsReloader.LoopbackClientConfig())
+	}
+
+	return grpc.DialContext(ctx, loopbackAddress(address),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+}
+
+// loopbackAddress turns a listen address like 0.0.0.0:9090 into one to dial.
+func loopbackAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start HTTP gateway server")
+		return address
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// Gateway HTTP server
+func runGatewayServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	server *gapi.Server,
+	healthChecker *health.Checker,
+	tlsReloader *tlsconfig.Reloader,
+) {
+	httpServer := &http.Server{
+		Handler: newGatewayHandler(ctx, config, server, healthChecker, tlsReloader, config.GRPCServerAddress),
+		Addr:    config.HTTPServerAddress,
+	}
+	if tlsReloader != nil {
+		httpServer.TLSConfig = tlsReloader.ServerConfig("h2", "http/1.1")
+	}
+
+	serveHTTP(ctx, waitGroup, httpServer, "HTTP gateway server", healthChecker.Shutdown)
+}
+
+// gRPC and the gateway on the HTTP server address, gRPC calls are told apart
+// by HTTP/2 and their content type. Without TLS HTTP/2 is h2c.
+func runSinglePortServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	server *gapi.Server,
+	healthChecker *health.Checker,
+	tlsReloader *tlsconfig.Reloader,
+) {
+	// the HTTP server terminates TLS, the peer of a call still has its TLS state
+	grpcServer, healthServer := newGrpcServer(server)
+	gatewayHandler := newGatewayHandler(ctx, config, server, healthChecker, tlsReloader, config.HTTPServerAddress)
+
+	singlePortHandler := gapi.NewSinglePortHandler(grpcServer, gatewayHandler)
+	httpServer := &http.Server{
+		Handler: singlePortHandler,
+		Addr:    config.HTTPServerAddress,
+	}
+	if tlsReloader != nil {
+		httpServer.TLSConfig = tlsReloader.ServerConfig("h2", "http/1.1")
 	}
+
+	waitGroup.Go(func() error {
+		// turns NOT_SERVING once ctx is done
+		healthChecker.Watch(ctx, healthServer, healthWatchInterval, pb.Snippetbox_ServiceDesc.ServiceName)
+		return nil
+	})
+
+	serveHTTP(ctx, waitGroup, httpServer, "gRPC and HTTP gateway server", func() {
+		healthChecker.Shutdown()
+
+		// waits for the gRPC calls, their HTTP/2 connections are hijacked by
+		// h2c so the HTTP server doesn't. GracefulStop would panic here.
+		drainCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := singlePortHandler.Drain(drainCtx); err != nil {
+			log.Error().Err(err).Msg("failed to drain gRPC calls")
+		}
+	})
+}
+
+// serveHTTP runs httpServer until ctx is done, beforeShutdown runs first on
+// shutdown.
+func serveHTTP(ctx context.Context, waitGroup *errgroup.Group, httpServer *http.Server, name string, beforeShutdown func()) {
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start %s at %s", name, httpServer.Addr)
+		var err error
+		if httpServer.TLSConfig != nil {
+			// the certificates come from the TLS config
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			log.Error().Err(err).Msgf("%s failed to serve", name)
+			return err
+		}
+
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msgf("graceful shutdown %s", name)
+		beforeShutdown()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		err := httpServer.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Error().Err(err).Msgf("failed to shutdown %s", name)
+			return err
+		}
+
+		log.Info().Msgf("%s is stopped", name)
+		return nil
+	})
 }
 
 // GIN server
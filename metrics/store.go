@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ObserveStore is a db.StoreObserver which records the latency of every
+// store method. A missing row is not counted as an error.
+func ObserveStore(ctx context.Context, method string) (context.Context, func(err error)) {
+	startTime := time.Now()
+
+	return ctx, func(err error) {
+		result := "ok"
+		if errors.Is(err, sql.ErrNoRows) {
+			result = "no_rows"
+		} else if err != nil {
+			result = "error"
+		}
+
+		DBQueryDuration.WithLabelValues(method, result).Observe(time.Since(startTime).Seconds())
+	}
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveStore(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		result string
+	}{
+		{name: "OK", err: nil, result: "ok"},
+		{name: "NoRows", err: sql.ErrNoRows, result: "no_rows"},
+		{name: "Error", err: errors.New("connection reset"), result: "error"},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			method := "Test" + tc.name
+
+			_, done := ObserveStore(context.Background(), method)
+			done(tc.err)
+
+			histogram := DBQueryDuration.WithLabelValues(method, tc.result).(prometheus.Metric)
+			metric := &dto.Metric{}
+			require.NoError(t, histogram.Write(metric))
+			require.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		})
+	}
+}
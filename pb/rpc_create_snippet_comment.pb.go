@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_create_snippet_comment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateSnippetCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnippetId int32  `protobuf:"varint,1,opt,name=snippet_id,json=snippetId,proto3" json:"snippet_id,omitempty"`
+	Body      string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// set to reply to a top level comment of the snippet
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// set all three to anchor a top level comment to a range of lines
+	LineStart *int32  `protobuf:"varint,4,opt,name=line_start,json=lineStart,proto3,oneof" json:"line_start,omitempty"`
+	LineEnd   *int32  `protobuf:"varint,5,opt,name=line_end,json=lineEnd,proto3,oneof" json:"line_end,omitempty"`
+	Filename  *string `protobuf:"bytes,6,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+}
+
+func (x *CreateSnippetCommentRequest) Reset() {
+	*x = CreateSnippetCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_snippet_comment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSnippetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnippetCommentRequest) ProtoMessage() {}
+
+func (x *CreateSnippetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_snippet_comment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnippetCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnippetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_snippet_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateSnippetCommentRequest) GetSnippetId() int32 {
+	if x != nil {
+		return x.SnippetId
+	}
+	return 0
+}
+
+func (x *CreateSnippetCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateSnippetCommentRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateSnippetCommentRequest) GetLineStart() int32 {
+	if x != nil && x.LineStart != nil {
+		return *x.LineStart
+	}
+	return 0
+}
+
+func (x *CreateSnippetCommentRequest) GetLineEnd() int32 {
+	if x != nil && x.LineEnd != nil {
+		return *x.LineEnd
+	}
+	return 0
+}
+
+func (x *CreateSnippetCommentRequest) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+type CreateSnippetCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *SnippetComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CreateSnippetCommentResponse) Reset() {
+	*x = CreateSnippetCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_snippet_comment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSnippetCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnippetCommentResponse) ProtoMessage() {}
+
+func (x *CreateSnippetCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_snippet_comment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnippetCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnippetCommentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_snippet_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSnippetCommentResponse) GetComment() *SnippetComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+var File_rpc_create_snippet_comment_proto protoreflect.FileDescriptor
+
+var file_rpc_create_snippet_comment_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x15, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x02,
+	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x6e,
+	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c,
+	0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x22, 0x5a, 0x20,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x63, 0x69, 0x70, 0x69,
+	0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x62, 0x6f, 0x78, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_snippet_comment_proto_rawDescOnce sync.Once
+	file_rpc_create_snippet_comment_proto_rawDescData = file_rpc_create_snippet_comment_proto_rawDesc
+)
+
+func file_rpc_create_snippet_comment_proto_rawDescGZIP() []byte {
+	file_rpc_create_snippet_comment_proto_rawDescOnce.Do(func() {
+		file_rpc_create_snippet_comment_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_snippet_comment_proto_rawDescData)
+	})
+	return file_rpc_create_snippet_comment_proto_rawDescData
+}
+
+var file_rpc_create_snippet_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_snippet_comment_proto_goTypes = []interface{}{
+	(*CreateSnippetCommentRequest)(nil),  // 0: pb.CreateSnippetCommentRequest
+	(*CreateSnippetCommentResponse)(nil), // 1: pb.CreateSnippetCommentResponse
+	(*SnippetComment)(nil),               // 2: pb.SnippetComment
+}
+var file_rpc_create_snippet_comment_proto_depIdxs = []int32{
+	2, // 0: pb.CreateSnippetCommentResponse.comment:type_name -> pb.SnippetComment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_snippet_comment_proto_init() }
+func file_rpc_create_snippet_comment_proto_init() {
+	if File_rpc_create_snippet_comment_proto != nil {
+		return
+	}
+	file_snippet_comment_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_snippet_comment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnippetCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_snippet_comment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnippetCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_create_snippet_comment_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_snippet_comment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_snippet_comment_proto_goTypes,
+		DependencyIndexes: file_rpc_create_snippet_comment_proto_depIdxs,
+		MessageInfos:      file_rpc_create_snippet_comment_proto_msgTypes,
+	}.Build()
+	File_rpc_create_snippet_comment_proto = out.File
+	file_rpc_create_snippet_comment_proto_rawDesc = nil
+	file_rpc_create_snippet_comment_proto_goTypes = nil
+	file_rpc_create_snippet_comment_proto_depIdxs = nil
+}
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_fork_snippet.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ForkSnippetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnippetId int32 `protobuf:"varint,1,opt,name=snippet_id,json=snippetId,proto3" json:"snippet_id,omitempty"`
+	// the account of the user that receives the fork
+	AccountId int32 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *ForkSnippetRequest) Reset() {
+	*x = ForkSnippetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_fork_snippet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkSnippetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkSnippetRequest) ProtoMessage() {}
+
+func (x *ForkSnippetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_fork_snippet_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkSnippetRequest.ProtoReflect.Descriptor instead.
+func (*ForkSnippetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_fork_snippet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForkSnippetRequest) GetSnippetId() int32 {
+	if x != nil {
+		return x.SnippetId
+	}
+	return 0
+}
+
+func (x *ForkSnippetRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type ForkSnippetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snippet *Snippet `protobuf:"bytes,1,opt,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *ForkSnippetResponse) Reset() {
+	*x = ForkSnippetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_fork_snippet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkSnippetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkSnippetResponse) ProtoMessage() {}
+
+func (x *ForkSnippetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_fork_snippet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkSnippetResponse.ProtoReflect.Descriptor instead.
+func (*ForkSnippetResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_fork_snippet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForkSnippetResponse) GetSnippet() *Snippet {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+var File_rpc_fork_snippet_proto protoreflect.FileDescriptor
+
+var file_rpc_fork_snippet_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0d, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x12, 0x46,
+	0x6f, 0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x3c, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x42, 0x22, 0x5a,
+	0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x63, 0x69, 0x70,
+	0x69, 0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x62, 0x6f, 0x78, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_fork_snippet_proto_rawDescOnce sync.Once
+	file_rpc_fork_snippet_proto_rawDescData = file_rpc_fork_snippet_proto_rawDesc
+)
+
+func file_rpc_fork_snippet_proto_rawDescGZIP() []byte {
+	file_rpc_fork_snippet_proto_rawDescOnce.Do(func() {
+		file_rpc_fork_snippet_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_fork_snippet_proto_rawDescData)
+	})
+	return file_rpc_fork_snippet_proto_rawDescData
+}
+
+var file_rpc_fork_snippet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_fork_snippet_proto_goTypes = []interface{}{
+	(*ForkSnippetRequest)(nil),  // 0: pb.ForkSnippetRequest
+	(*ForkSnippetResponse)(nil), // 1: pb.ForkSnippetResponse
+	(*Snippet)(nil),             // 2: pb.Snippet
+}
+var file_rpc_fork_snippet_proto_depIdxs = []int32{
+	2, // 0: pb.ForkSnippetResponse.snippet:type_name -> pb.Snippet
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_fork_snippet_proto_init() }
+func file_rpc_fork_snippet_proto_init() {
+	if File_rpc_fork_snippet_proto != nil {
+		return
+	}
+	file_snippet_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_fork_snippet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkSnippetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_fork_snippet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkSnippetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_fork_snippet_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_fork_snippet_proto_goTypes,
+		DependencyIndexes: file_rpc_fork_snippet_proto_depIdxs,
+		MessageInfos:      file_rpc_fork_snippet_proto_msgTypes,
+	}.Build()
+	File_rpc_fork_snippet_proto = out.File
+	file_rpc_fork_snippet_proto_rawDesc = nil
+	file_rpc_fork_snippet_proto_goTypes = nil
+	file_rpc_fork_snippet_proto_depIdxs = nil
+}
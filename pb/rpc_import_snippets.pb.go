@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_import_snippets.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportSnippetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId       int32           `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Format          ImportFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=pb.ImportFormat" json:"format,omitempty"`
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,3,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=pb.DuplicatePolicy" json:"duplicate_policy,omitempty"`
+	Archive         []byte          `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *ImportSnippetsRequest) Reset() {
+	*x = ImportSnippetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_import_snippets_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSnippetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSnippetsRequest) ProtoMessage() {}
+
+func (x *ImportSnippetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_import_snippets_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSnippetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSnippetsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_import_snippets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportSnippetsRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ImportSnippetsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportSnippetsRequest) GetDuplicatePolicy() DuplicatePolicy {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED
+}
+
+func (x *ImportSnippetsRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type ImportSnippetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnippetImport *SnippetImport `protobuf:"bytes,1,opt,name=snippet_import,json=snippetImport,proto3" json:"snippet_import,omitempty"`
+}
+
+func (x *ImportSnippetsResponse) Reset() {
+	*x = ImportSnippetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_import_snippets_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSnippetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSnippetsResponse) ProtoMessage() {}
+
+func (x *ImportSnippetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_import_snippets_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSnippetsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSnippetsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_import_snippets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportSnippetsResponse) GetSnippetImport() *SnippetImport {
+	if x != nil {
+		return x.SnippetImport
+	}
+	return nil
+}
+
+var File_rpc_import_snippets_proto protoreflect.FileDescriptor
+
+var file_rpc_import_snippets_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x14, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x10, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0e,
+	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0d, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x63, 0x69, 0x70, 0x69, 0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x62, 0x6f, 0x78, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_import_snippets_proto_rawDescOnce sync.Once
+	file_rpc_import_snippets_proto_rawDescData = file_rpc_import_snippets_proto_rawDesc
+)
+
+func file_rpc_import_snippets_proto_rawDescGZIP() []byte {
+	file_rpc_import_snippets_proto_rawDescOnce.Do(func() {
+		file_rpc_import_snippets_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_import_snippets_proto_rawDescData)
+	})
+	return file_rpc_import_snippets_proto_rawDescData
+}
+
+var file_rpc_import_snippets_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_import_snippets_proto_goTypes = []interface{}{
+	(*ImportSnippetsRequest)(nil),  // 0: pb.ImportSnippetsRequest
+	(*ImportSnippetsResponse)(nil), // 1: pb.ImportSnippetsResponse
+	(ImportFormat)(0),              // 2: pb.ImportFormat
+	(DuplicatePolicy)(0),           // 3: pb.DuplicatePolicy
+	(*SnippetImport)(nil),          // 4: pb.SnippetImport
+}
+var file_rpc_import_snippets_proto_depIdxs = []int32{
+	2, // 0: pb.ImportSnippetsRequest.format:type_name -> pb.ImportFormat
+	3, // 1: pb.ImportSnippetsRequest.duplicate_policy:type_name -> pb.DuplicatePolicy
+	4, // 2: pb.ImportSnippetsResponse.snippet_import:type_name -> pb.SnippetImport
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_import_snippets_proto_init() }
+func file_rpc_import_snippets_proto_init() {
+	if File_rpc_import_snippets_proto != nil {
+		return
+	}
+	file_snippet_import_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_import_snippets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSnippetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_import_snippets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSnippetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_import_snippets_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_import_snippets_proto_goTypes,
+		DependencyIndexes: file_rpc_import_snippets_proto_depIdxs,
+		MessageInfos:      file_rpc_import_snippets_proto_msgTypes,
+	}.Build()
+	File_rpc_import_snippets_proto = out.File
+	file_rpc_import_snippets_proto_rawDesc = nil
+	file_rpc_import_snippets_proto_goTypes = nil
+	file_rpc_import_snippets_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_remove_collection_snippet.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RemoveCollectionSnippetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	SnippetId    int32 `protobuf:"varint,2,opt,name=snippet_id,json=snippetId,proto3" json:"snippet_id,omitempty"`
+}
+
+func (x *RemoveCollectionSnippetRequest) Reset() {
+	*x = RemoveCollectionSnippetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_remove_collection_snippet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCollectionSnippetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionSnippetRequest) ProtoMessage() {}
+
+func (x *RemoveCollectionSnippetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_remove_collection_snippet_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionSnippetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionSnippetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_remove_collection_snippet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoveCollectionSnippetRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *RemoveCollectionSnippetRequest) GetSnippetId() int32 {
+	if x != nil {
+		return x.SnippetId
+	}
+	return 0
+}
+
+type RemoveCollectionSnippetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCollectionSnippetResponse) Reset() {
+	*x = RemoveCollectionSnippetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_remove_collection_snippet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCollectionSnippetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionSnippetResponse) ProtoMessage() {}
+
+func (x *RemoveCollectionSnippetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_remove_collection_snippet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionSnippetResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionSnippetResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_remove_collection_snippet_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_remove_collection_snippet_proto protoreflect.FileDescriptor
+
+var file_rpc_remove_collection_snippet_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x64, 0x0a, 0x1e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x21, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x63, 0x69, 0x70, 0x69, 0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x62, 0x6f, 0x78, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_remove_collection_snippet_proto_rawDescOnce sync.Once
+	file_rpc_remove_collection_snippet_proto_rawDescData = file_rpc_remove_collection_snippet_proto_rawDesc
+)
+
+func file_rpc_remove_collection_snippet_proto_rawDescGZIP() []byte {
+	file_rpc_remove_collection_snippet_proto_rawDescOnce.Do(func() {
+		file_rpc_remove_collection_snippet_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_remove_collection_snippet_proto_rawDescData)
+	})
+	return file_rpc_remove_collection_snippet_proto_rawDescData
+}
+
+var file_rpc_remove_collection_snippet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_remove_collection_snippet_proto_goTypes = []interface{}{
+	(*RemoveCollectionSnippetRequest)(nil),  // 0: pb.RemoveCollectionSnippetRequest
+	(*RemoveCollectionSnippetResponse)(nil), // 1: pb.RemoveCollectionSnippetResponse
+}
+var file_rpc_remove_collection_snippet_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_remove_collection_snippet_proto_init() }
+func file_rpc_remove_collection_snippet_proto_init() {
+	if File_rpc_remove_collection_snippet_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_remove_collection_snippet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCollectionSnippetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_remove_collection_snippet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCollectionSnippetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_remove_collection_snippet_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_remove_collection_snippet_proto_goTypes,
+		DependencyIndexes: file_rpc_remove_collection_snippet_proto_depIdxs,
+		MessageInfos:      file_rpc_remove_collection_snippet_proto_msgTypes,
+	}.Build()
+	File_rpc_remove_collection_snippet_proto = out.File
+	file_rpc_remove_collection_snippet_proto_rawDesc = nil
+	file_rpc_remove_collection_snippet_proto_goTypes = nil
+	file_rpc_remove_collection_snippet_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_transfer_account.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransferAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId int32 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Types that are assignable to Owner:
+	//	*TransferAccountRequest_OrganizationId
+	//	*TransferAccountRequest_Username
+	Owner isTransferAccountRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *TransferAccountRequest) Reset() {
+	*x = TransferAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_transfer_account_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountRequest) ProtoMessage() {}
+
+func (x *TransferAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_transfer_account_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountRequest.ProtoReflect.Descriptor instead.
+func (*TransferAccountRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_transfer_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferAccountRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (m *TransferAccountRequest) GetOwner() isTransferAccountRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *TransferAccountRequest) GetOrganizationId() int64 {
+	if x, ok := x.GetOwner().(*TransferAccountRequest_OrganizationId); ok {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *TransferAccountRequest) GetUsername() string {
+	if x, ok := x.GetOwner().(*TransferAccountRequest_Username); ok {
+		return x.Username
+	}
+	return ""
+}
+
+type isTransferAccountRequest_Owner interface {
+	isTransferAccountRequest_Owner()
+}
+
+type TransferAccountRequest_OrganizationId struct {
+	// move a personal account into the organization
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3,oneof"`
+}
+
+type TransferAccountRequest_Username struct {
+	// move an organization account to one of its members
+	Username string `protobuf:"bytes,3,opt,name=username,proto3,oneof"`
+}
+
+func (*TransferAccountRequest_OrganizationId) isTransferAccountRequest_Owner() {}
+
+func (*TransferAccountRequest_Username) isTransferAccountRequest_Owner() {}
+
+type TransferAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *TransferAccountResponse) Reset() {
+	*x = TransferAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_transfer_account_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountResponse) ProtoMessage() {}
+
+func (x *TransferAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_transfer_account_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountResponse.ProtoReflect.Descriptor instead.
+func (*TransferAccountResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_transfer_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+var File_rpc_transfer_account_proto protoreflect.FileDescriptor
+
+var file_rpc_transfer_account_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x12, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0x40, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x63, 0x69, 0x70, 0x69, 0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x62, 0x6f, 0x78, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_transfer_account_proto_rawDescOnce sync.Once
+	file_rpc_transfer_account_proto_rawDescData = file_rpc_transfer_account_proto_rawDesc
+)
+
+func file_rpc_transfer_account_proto_rawDescGZIP() []byte {
+	file_rpc_transfer_account_proto_rawDescOnce.Do(func() {
+		file_rpc_transfer_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_transfer_account_proto_rawDescData)
+	})
+	return file_rpc_transfer_account_proto_rawDescData
+}
+
+var file_rpc_transfer_account_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_transfer_account_proto_goTypes = []interface{}{
+	(*TransferAccountRequest)(nil),  // 0: pb.TransferAccountRequest
+	(*TransferAccountResponse)(nil), // 1: pb.TransferAccountResponse
+	(*Account)(nil),                 // 2: pb.Account
+}
+var file_rpc_transfer_account_proto_depIdxs = []int32{
+	2, // 0: pb.TransferAccountResponse.account:type_name -> pb.Account
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_transfer_account_proto_init() }
+func file_rpc_transfer_account_proto_init() {
+	if File_rpc_transfer_account_proto != nil {
+		return
+	}
+	file_organization_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_transfer_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_transfer_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_transfer_account_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TransferAccountRequest_OrganizationId)(nil),
+		(*TransferAccountRequest_Username)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_transfer_account_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_transfer_account_proto_goTypes,
+		DependencyIndexes: file_rpc_transfer_account_proto_depIdxs,
+		MessageInfos:      file_rpc_transfer_account_proto_msgTypes,
+	}.Build()
+	File_rpc_transfer_account_proto = out.File
+	file_rpc_transfer_account_proto_rawDesc = nil
+	file_rpc_transfer_account_proto_goTypes = nil
+	file_rpc_transfer_account_proto_depIdxs = nil
+}
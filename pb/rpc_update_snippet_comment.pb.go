@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: rpc_update_snippet_comment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateSnippetCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *UpdateSnippetCommentRequest) Reset() {
+	*x = UpdateSnippetCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_update_snippet_comment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSnippetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSnippetCommentRequest) ProtoMessage() {}
+
+func (x *UpdateSnippetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_snippet_comment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSnippetCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSnippetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_snippet_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateSnippetCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSnippetCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateSnippetCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *SnippetComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *UpdateSnippetCommentResponse) Reset() {
+	*x = UpdateSnippetCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_update_snippet_comment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSnippetCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSnippetCommentResponse) ProtoMessage() {}
+
+func (x *UpdateSnippetCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_snippet_comment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSnippetCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSnippetCommentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_snippet_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateSnippetCommentResponse) GetComment() *SnippetComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+var File_rpc_update_snippet_comment_proto protoreflect.FileDescriptor
+
+var file_rpc_update_snippet_comment_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x15, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x41, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0x4c, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x22,
+	0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x63, 0x69,
+	0x70, 0x69, 0x69, 0x61, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x62, 0x6f, 0x78, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_update_snippet_comment_proto_rawDescOnce sync.Once
+	file_rpc_update_snippet_comment_proto_rawDescData = file_rpc_update_snippet_comment_proto_rawDesc
+)
+
+func file_rpc_update_snippet_comment_proto_rawDescGZIP() []byte {
+	file_rpc_update_snippet_comment_proto_rawDescOnce.Do(func() {
+		file_rpc_update_snippet_comment_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_update_snippet_comment_proto_rawDescData)
+	})
+	return file_rpc_update_snippet_comment_proto_rawDescData
+}
+
+var file_rpc_update_snippet_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_snippet_comment_proto_goTypes = []interface{}{
+	(*UpdateSnippetCommentRequest)(nil),  // 0: pb.UpdateSnippetCommentRequest
+	(*UpdateSnippetCommentResponse)(nil), // 1: pb.UpdateSnippetCommentResponse
+	(*SnippetComment)(nil),               // 2: pb.SnippetComment
+}
+var file_rpc_update_snippet_comment_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateSnippetCommentResponse.comment:type_name -> pb.SnippetComment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_snippet_comment_proto_init() }
+func file_rpc_update_snippet_comment_proto_init() {
+	if File_rpc_update_snippet_comment_proto != nil {
+		return
+	}
+	file_snippet_comment_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_update_snippet_comment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSnippetCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_update_snippet_comment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSnippetCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_update_snippet_comment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_snippet_comment_proto_goTypes,
+		DependencyIndexes: file_rpc_update_snippet_comment_proto_depIdxs,
+		MessageInfos:      file_rpc_update_snippet_comment_proto_msgTypes,
+	}.Build()
+	File_rpc_update_snippet_comment_proto = out.File
+	file_rpc_update_snippet_comment_proto_rawDesc = nil
+	file_rpc_update_snippet_comment_proto_goTypes = nil
+	file_rpc_update_snippet_comment_proto_depIdxs = nil
+}
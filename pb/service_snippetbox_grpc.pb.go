@@ -19,9 +19,55 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Snippetbox_CreateUser_FullMethodName = "/pb.Snippetbox/CreateUser"
-	Snippetbox_UpdateUser_FullMethodName = "/pb.Snippetbox/UpdateUser"
-	Snippetbox_LoginUser_FullMethodName  = "/pb.Snippetbox/LoginUser"
+	Snippetbox_CreateUser_FullMethodName                    = "/pb.Snippetbox/CreateUser"
+	Snippetbox_UpdateUser_FullMethodName                    = "/pb.Snippetbox/UpdateUser"
+	Snippetbox_LoginUser_FullMethodName                     = "/pb.Snippetbox/LoginUser"
+	Snippetbox_ListTaskQueues_FullMethodName                = "/pb.Snippetbox/ListTaskQueues"
+	Snippetbox_ListTasks_FullMethodName                     = "/pb.Snippetbox/ListTasks"
+	Snippetbox_RetryTask_FullMethodName                     = "/pb.Snippetbox/RetryTask"
+	Snippetbox_DeleteTask_FullMethodName                    = "/pb.Snippetbox/DeleteTask"
+	Snippetbox_PauseTaskQueue_FullMethodName                = "/pb.Snippetbox/PauseTaskQueue"
+	Snippetbox_ResumeTaskQueue_FullMethodName               = "/pb.Snippetbox/ResumeTaskQueue"
+	Snippetbox_CreateWebhook_FullMethodName                 = "/pb.Snippetbox/CreateWebhook"
+	Snippetbox_ListWebhooks_FullMethodName                  = "/pb.Snippetbox/ListWebhooks"
+	Snippetbox_UpdateWebhook_FullMethodName                 = "/pb.Snippetbox/UpdateWebhook"
+	Snippetbox_DeleteWebhook_FullMethodName                 = "/pb.Snippetbox/DeleteWebhook"
+	Snippetbox_ListWebhookDeliveries_FullMethodName         = "/pb.Snippetbox/ListWebhookDeliveries"
+	Snippetbox_RedeliverWebhook_FullMethodName              = "/pb.Snippetbox/RedeliverWebhook"
+	Snippetbox_ImportSnippets_FullMethodName                = "/pb.Snippetbox/ImportSnippets"
+	Snippetbox_GetSnippetImport_FullMethodName              = "/pb.Snippetbox/GetSnippetImport"
+	Snippetbox_RequestExport_FullMethodName                 = "/pb.Snippetbox/RequestExport"
+	Snippetbox_GetExport_FullMethodName                     = "/pb.Snippetbox/GetExport"
+	Snippetbox_RevokeSession_FullMethodName                 = "/pb.Snippetbox/RevokeSession"
+	Snippetbox_ListAuditEvents_FullMethodName               = "/pb.Snippetbox/ListAuditEvents"
+	Snippetbox_SearchAuditEvents_FullMethodName             = "/pb.Snippetbox/SearchAuditEvents"
+	Snippetbox_CreateOrganization_FullMethodName            = "/pb.Snippetbox/CreateOrganization"
+	Snippetbox_ListOrganizations_FullMethodName             = "/pb.Snippetbox/ListOrganizations"
+	Snippetbox_ListOrganizationMembers_FullMethodName       = "/pb.Snippetbox/ListOrganizationMembers"
+	Snippetbox_InviteOrganizationMember_FullMethodName      = "/pb.Snippetbox/InviteOrganizationMember"
+	Snippetbox_ListOrganizationInvitations_FullMethodName   = "/pb.Snippetbox/ListOrganizationInvitations"
+	Snippetbox_RespondOrganizationInvitation_FullMethodName = "/pb.Snippetbox/RespondOrganizationInvitation"
+	Snippetbox_UpdateOrganizationMember_FullMethodName      = "/pb.Snippetbox/UpdateOrganizationMember"
+	Snippetbox_RemoveOrganizationMember_FullMethodName      = "/pb.Snippetbox/RemoveOrganizationMember"
+	Snippetbox_TransferAccount_FullMethodName               = "/pb.Snippetbox/TransferAccount"
+	Snippetbox_CreateCollection_FullMethodName              = "/pb.Snippetbox/CreateCollection"
+	Snippetbox_ListCollections_FullMethodName               = "/pb.Snippetbox/ListCollections"
+	Snippetbox_RenameCollection_FullMethodName              = "/pb.Snippetbox/RenameCollection"
+	Snippetbox_MoveCollection_FullMethodName                = "/pb.Snippetbox/MoveCollection"
+	Snippetbox_DeleteCollection_FullMethodName              = "/pb.Snippetbox/DeleteCollection"
+	Snippetbox_AddCollectionSnippet_FullMethodName          = "/pb.Snippetbox/AddCollectionSnippet"
+	Snippetbox_RemoveCollectionSnippet_FullMethodName       = "/pb.Snippetbox/RemoveCollectionSnippet"
+	Snippetbox_ReorderCollectionSnippets_FullMethodName     = "/pb.Snippetbox/ReorderCollectionSnippets"
+	Snippetbox_StarSnippet_FullMethodName                   = "/pb.Snippetbox/StarSnippet"
+	Snippetbox_UnstarSnippet_FullMethodName                 = "/pb.Snippetbox/UnstarSnippet"
+	Snippetbox_ListStarredSnippets_FullMethodName           = "/pb.Snippetbox/ListStarredSnippets"
+	Snippetbox_ForkSnippet_FullMethodName                   = "/pb.Snippetbox/ForkSnippet"
+	Snippetbox_ListSnippetForks_FullMethodName              = "/pb.Snippetbox/ListSnippetForks"
+	Snippetbox_GetSnippetStats_FullMethodName               = "/pb.Snippetbox/GetSnippetStats"
+	Snippetbox_CreateSnippetComment_FullMethodName          = "/pb.Snippetbox/CreateSnippetComment"
+	Snippetbox_ListSnippetComments_FullMethodName           = "/pb.Snippetbox/ListSnippetComments"
+	Snippetbox_UpdateSnippetComment_FullMethodName          = "/pb.Snippetbox/UpdateSnippetComment"
+	Snippetbox_DeleteSnippetComment_FullMethodName          = "/pb.Snippetbox/DeleteSnippetComment"
 )
 
 // SnippetboxClient is the client API for Snippetbox service.
@@ -31,6 +77,52 @@ type SnippetboxClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	ListTaskQueues(ctx context.Context, in *ListTaskQueuesRequest, opts ...grpc.CallOption) (*ListTaskQueuesResponse, error)
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...grpc.CallOption) (*RetryTaskResponse, error)
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error)
+	ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	ImportSnippets(ctx context.Context, in *ImportSnippetsRequest, opts ...grpc.CallOption) (*ImportSnippetsResponse, error)
+	GetSnippetImport(ctx context.Context, in *GetSnippetImportRequest, opts ...grpc.CallOption) (*GetSnippetImportResponse, error)
+	RequestExport(ctx context.Context, in *RequestExportRequest, opts ...grpc.CallOption) (*RequestExportResponse, error)
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	SearchAuditEvents(ctx context.Context, in *SearchAuditEventsRequest, opts ...grpc.CallOption) (*SearchAuditEventsResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+	InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error)
+	ListOrganizationInvitations(ctx context.Context, in *ListOrganizationInvitationsRequest, opts ...grpc.CallOption) (*ListOrganizationInvitationsResponse, error)
+	RespondOrganizationInvitation(ctx context.Context, in *RespondOrganizationInvitationRequest, opts ...grpc.CallOption) (*RespondOrganizationInvitationResponse, error)
+	UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error)
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
+	TransferAccount(ctx context.Context, in *TransferAccountRequest, opts ...grpc.CallOption) (*TransferAccountResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error)
+	MoveCollection(ctx context.Context, in *MoveCollectionRequest, opts ...grpc.CallOption) (*MoveCollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	AddCollectionSnippet(ctx context.Context, in *AddCollectionSnippetRequest, opts ...grpc.CallOption) (*AddCollectionSnippetResponse, error)
+	RemoveCollectionSnippet(ctx context.Context, in *RemoveCollectionSnippetRequest, opts ...grpc.CallOption) (*RemoveCollectionSnippetResponse, error)
+	ReorderCollectionSnippets(ctx context.Context, in *ReorderCollectionSnippetsRequest, opts ...grpc.CallOption) (*ReorderCollectionSnippetsResponse, error)
+	StarSnippet(ctx context.Context, in *StarSnippetRequest, opts ...grpc.CallOption) (*StarSnippetResponse, error)
+	UnstarSnippet(ctx context.Context, in *UnstarSnippetRequest, opts ...grpc.CallOption) (*UnstarSnippetResponse, error)
+	ListStarredSnippets(ctx context.Context, in *ListStarredSnippetsRequest, opts ...grpc.CallOption) (*ListStarredSnippetsResponse, error)
+	ForkSnippet(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error)
+	ListSnippetForks(ctx context.Context, in *ListSnippetForksRequest, opts ...grpc.CallOption) (*ListSnippetForksResponse, error)
+	GetSnippetStats(ctx context.Context, in *GetSnippetStatsRequest, opts ...grpc.CallOption) (*GetSnippetStatsResponse, error)
+	CreateSnippetComment(ctx context.Context, in *CreateSnippetCommentRequest, opts ...grpc.CallOption) (*CreateSnippetCommentResponse, error)
+	ListSnippetComments(ctx context.Context, in *ListSnippetCommentsRequest, opts ...grpc.CallOption) (*ListSnippetCommentsResponse, error)
+	UpdateSnippetComment(ctx context.Context, in *UpdateSnippetCommentRequest, opts ...grpc.CallOption) (*UpdateSnippetCommentResponse, error)
+	DeleteSnippetComment(ctx context.Context, in *DeleteSnippetCommentRequest, opts ...grpc.CallOption) (*DeleteSnippetCommentResponse, error)
 }
 
 type snippetboxClient struct {
@@ -68,6 +160,420 @@ func (c *snippetboxClient) LoginUser(ctx context.Context, in *LoginUserRequest,
 	return out, nil
 }
 
+func (c *snippetboxClient) ListTaskQueues(ctx context.Context, in *ListTaskQueuesRequest, opts ...grpc.CallOption) (*ListTaskQueuesResponse, error) {
+	out := new(ListTaskQueuesResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListTaskQueues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...grpc.CallOption) (*RetryTaskResponse, error) {
+	out := new(RetryTaskResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RetryTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
+	out := new(DeleteTaskResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_DeleteTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error) {
+	out := new(PauseTaskQueueResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_PauseTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error) {
+	out := new(ResumeTaskQueueResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ResumeTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RedeliverWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ImportSnippets(ctx context.Context, in *ImportSnippetsRequest, opts ...grpc.CallOption) (*ImportSnippetsResponse, error) {
+	out := new(ImportSnippetsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ImportSnippets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) GetSnippetImport(ctx context.Context, in *GetSnippetImportRequest, opts ...grpc.CallOption) (*GetSnippetImportResponse, error) {
+	out := new(GetSnippetImportResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_GetSnippetImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RequestExport(ctx context.Context, in *RequestExportRequest, opts ...grpc.CallOption) (*RequestExportResponse, error) {
+	out := new(RequestExportResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RequestExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportResponse, error) {
+	out := new(GetExportResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_GetExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) SearchAuditEvents(ctx context.Context, in *SearchAuditEventsRequest, opts ...grpc.CallOption) (*SearchAuditEventsResponse, error) {
+	out := new(SearchAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_SearchAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_CreateOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListOrganizations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
+	out := new(ListOrganizationMembersResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListOrganizationMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error) {
+	out := new(InviteOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_InviteOrganizationMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListOrganizationInvitations(ctx context.Context, in *ListOrganizationInvitationsRequest, opts ...grpc.CallOption) (*ListOrganizationInvitationsResponse, error) {
+	out := new(ListOrganizationInvitationsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListOrganizationInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RespondOrganizationInvitation(ctx context.Context, in *RespondOrganizationInvitationRequest, opts ...grpc.CallOption) (*RespondOrganizationInvitationResponse, error) {
+	out := new(RespondOrganizationInvitationResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RespondOrganizationInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error) {
+	out := new(UpdateOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_UpdateOrganizationMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RemoveOrganizationMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) TransferAccount(ctx context.Context, in *TransferAccountRequest, opts ...grpc.CallOption) (*TransferAccountResponse, error) {
+	out := new(TransferAccountResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_TransferAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_CreateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error) {
+	out := new(RenameCollectionResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RenameCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) MoveCollection(ctx context.Context, in *MoveCollectionRequest, opts ...grpc.CallOption) (*MoveCollectionResponse, error) {
+	out := new(MoveCollectionResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_MoveCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_DeleteCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) AddCollectionSnippet(ctx context.Context, in *AddCollectionSnippetRequest, opts ...grpc.CallOption) (*AddCollectionSnippetResponse, error) {
+	out := new(AddCollectionSnippetResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_AddCollectionSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) RemoveCollectionSnippet(ctx context.Context, in *RemoveCollectionSnippetRequest, opts ...grpc.CallOption) (*RemoveCollectionSnippetResponse, error) {
+	out := new(RemoveCollectionSnippetResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_RemoveCollectionSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ReorderCollectionSnippets(ctx context.Context, in *ReorderCollectionSnippetsRequest, opts ...grpc.CallOption) (*ReorderCollectionSnippetsResponse, error) {
+	out := new(ReorderCollectionSnippetsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ReorderCollectionSnippets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) StarSnippet(ctx context.Context, in *StarSnippetRequest, opts ...grpc.CallOption) (*StarSnippetResponse, error) {
+	out := new(StarSnippetResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_StarSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) UnstarSnippet(ctx context.Context, in *UnstarSnippetRequest, opts ...grpc.CallOption) (*UnstarSnippetResponse, error) {
+	out := new(UnstarSnippetResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_UnstarSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListStarredSnippets(ctx context.Context, in *ListStarredSnippetsRequest, opts ...grpc.CallOption) (*ListStarredSnippetsResponse, error) {
+	out := new(ListStarredSnippetsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListStarredSnippets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ForkSnippet(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error) {
+	out := new(ForkSnippetResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ForkSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListSnippetForks(ctx context.Context, in *ListSnippetForksRequest, opts ...grpc.CallOption) (*ListSnippetForksResponse, error) {
+	out := new(ListSnippetForksResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListSnippetForks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) GetSnippetStats(ctx context.Context, in *GetSnippetStatsRequest, opts ...grpc.CallOption) (*GetSnippetStatsResponse, error) {
+	out := new(GetSnippetStatsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_GetSnippetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) CreateSnippetComment(ctx context.Context, in *CreateSnippetCommentRequest, opts ...grpc.CallOption) (*CreateSnippetCommentResponse, error) {
+	out := new(CreateSnippetCommentResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_CreateSnippetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) ListSnippetComments(ctx context.Context, in *ListSnippetCommentsRequest, opts ...grpc.CallOption) (*ListSnippetCommentsResponse, error) {
+	out := new(ListSnippetCommentsResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_ListSnippetComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) UpdateSnippetComment(ctx context.Context, in *UpdateSnippetCommentRequest, opts ...grpc.CallOption) (*UpdateSnippetCommentResponse, error) {
+	out := new(UpdateSnippetCommentResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_UpdateSnippetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snippetboxClient) DeleteSnippetComment(ctx context.Context, in *DeleteSnippetCommentRequest, opts ...grpc.CallOption) (*DeleteSnippetCommentResponse, error) {
+	out := new(DeleteSnippetCommentResponse)
+	err := c.cc.Invoke(ctx, Snippetbox_DeleteSnippetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SnippetboxServer is the server API for Snippetbox service.
 // All implementations must embed UnimplementedSnippetboxServer
 // for forward compatibility
@@ -75,85 +581,1097 @@ type SnippetboxServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	ListTaskQueues(context.Context, *ListTaskQueuesRequest) (*ListTaskQueuesResponse, error)
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error)
+	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error)
+	ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	ImportSnippets(context.Context, *ImportSnippetsRequest) (*ImportSnippetsResponse, error)
+	GetSnippetImport(context.Context, *GetSnippetImportRequest) (*GetSnippetImportResponse, error)
+	RequestExport(context.Context, *RequestExportRequest) (*RequestExportResponse, error)
+	GetExport(context.Context, *GetExportRequest) (*GetExportResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	SearchAuditEvents(context.Context, *SearchAuditEventsRequest) (*SearchAuditEventsResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error)
+	ListOrganizationInvitations(context.Context, *ListOrganizationInvitationsRequest) (*ListOrganizationInvitationsResponse, error)
+	RespondOrganizationInvitation(context.Context, *RespondOrganizationInvitationRequest) (*RespondOrganizationInvitationResponse, error)
+	UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	TransferAccount(context.Context, *TransferAccountRequest) (*TransferAccountResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error)
+	MoveCollection(context.Context, *MoveCollectionRequest) (*MoveCollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	AddCollectionSnippet(context.Context, *AddCollectionSnippetRequest) (*AddCollectionSnippetResponse, error)
+	RemoveCollectionSnippet(context.Context, *RemoveCollectionSnippetRequest) (*RemoveCollectionSnippetResponse, error)
+	ReorderCollectionSnippets(context.Context, *ReorderCollectionSnippetsRequest) (*ReorderCollectionSnippetsResponse, error)
+	StarSnippet(context.Context, *StarSnippetRequest) (*StarSnippetResponse, error)
+	UnstarSnippet(context.Context, *UnstarSnippetRequest) (*UnstarSnippetResponse, error)
+	ListStarredSnippets(context.Context, *ListStarredSnippetsRequest) (*ListStarredSnippetsResponse, error)
+	ForkSnippet(context.Context, *ForkSnippetRequest) (*ForkSnippetResponse, error)
+	ListSnippetForks(context.Context, *ListSnippetForksRequest) (*ListSnippetForksResponse, error)
+	GetSnippetStats(context.Context, *GetSnippetStatsRequest) (*GetSnippetStatsResponse, error)
+	CreateSnippetComment(context.Context, *CreateSnippetCommentRequest) (*CreateSnippetCommentResponse, error)
+	ListSnippetComments(context.Context, *ListSnippetCommentsRequest) (*ListSnippetCommentsResponse, error)
+	UpdateSnippetComment(context.Context, *UpdateSnippetCommentRequest) (*UpdateSnippetCommentResponse, error)
+	DeleteSnippetComment(context.Context, *DeleteSnippetCommentRequest) (*DeleteSnippetCommentResponse, error)
+	mustEmbedUnimplementedSnippetboxServer()
+}
+
+// UnimplementedSnippetboxServer must be embedded to have forward compatible implementations.
+type UnimplementedSnippetboxServer struct {
+}
+
+func (UnimplementedSnippetboxServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSnippetboxServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSnippetboxServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedSnippetboxServer) ListTaskQueues(context.Context, *ListTaskQueuesRequest) (*ListTaskQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueues not implemented")
+}
+func (UnimplementedSnippetboxServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSnippetboxServer) RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTask not implemented")
+}
+func (UnimplementedSnippetboxServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedSnippetboxServer) PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskQueue not implemented")
+}
+func (UnimplementedSnippetboxServer) ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskQueue not implemented")
+}
+func (UnimplementedSnippetboxServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedSnippetboxServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedSnippetboxServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedSnippetboxServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedSnippetboxServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedSnippetboxServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedSnippetboxServer) ImportSnippets(context.Context, *ImportSnippetsRequest) (*ImportSnippetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSnippets not implemented")
+}
+func (UnimplementedSnippetboxServer) GetSnippetImport(context.Context, *GetSnippetImportRequest) (*GetSnippetImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnippetImport not implemented")
+}
+func (UnimplementedSnippetboxServer) RequestExport(context.Context, *RequestExportRequest) (*RequestExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestExport not implemented")
+}
+func (UnimplementedSnippetboxServer) GetExport(context.Context, *GetExportRequest) (*GetExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedSnippetboxServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSnippetboxServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedSnippetboxServer) SearchAuditEvents(context.Context, *SearchAuditEventsRequest) (*SearchAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditEvents not implemented")
+}
+func (UnimplementedSnippetboxServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedSnippetboxServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedSnippetboxServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
+}
+func (UnimplementedSnippetboxServer) InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteOrganizationMember not implemented")
+}
+func (UnimplementedSnippetboxServer) ListOrganizationInvitations(context.Context, *ListOrganizationInvitationsRequest) (*ListOrganizationInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationInvitations not implemented")
+}
+func (UnimplementedSnippetboxServer) RespondOrganizationInvitation(context.Context, *RespondOrganizationInvitationRequest) (*RespondOrganizationInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondOrganizationInvitation not implemented")
+}
+func (UnimplementedSnippetboxServer) UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationMember not implemented")
+}
+func (UnimplementedSnippetboxServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedSnippetboxServer) TransferAccount(context.Context, *TransferAccountRequest) (*TransferAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAccount not implemented")
+}
+func (UnimplementedSnippetboxServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedSnippetboxServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedSnippetboxServer) RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedSnippetboxServer) MoveCollection(context.Context, *MoveCollectionRequest) (*MoveCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollection not implemented")
+}
+func (UnimplementedSnippetboxServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedSnippetboxServer) AddCollectionSnippet(context.Context, *AddCollectionSnippetRequest) (*AddCollectionSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionSnippet not implemented")
+}
+func (UnimplementedSnippetboxServer) RemoveCollectionSnippet(context.Context, *RemoveCollectionSnippetRequest) (*RemoveCollectionSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionSnippet not implemented")
+}
+func (UnimplementedSnippetboxServer) ReorderCollectionSnippets(context.Context, *ReorderCollectionSnippetsRequest) (*ReorderCollectionSnippetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollectionSnippets not implemented")
+}
+func (UnimplementedSnippetboxServer) StarSnippet(context.Context, *StarSnippetRequest) (*StarSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarSnippet not implemented")
+}
+func (UnimplementedSnippetboxServer) UnstarSnippet(context.Context, *UnstarSnippetRequest) (*UnstarSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstarSnippet not implemented")
+}
+func (UnimplementedSnippetboxServer) ListStarredSnippets(context.Context, *ListStarredSnippetsRequest) (*ListStarredSnippetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStarredSnippets not implemented")
+}
+func (UnimplementedSnippetboxServer) ForkSnippet(context.Context, *ForkSnippetRequest) (*ForkSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkSnippet not implemented")
+}
+func (UnimplementedSnippetboxServer) ListSnippetForks(context.Context, *ListSnippetForksRequest) (*ListSnippetForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnippetForks not implemented")
+}
+func (UnimplementedSnippetboxServer) GetSnippetStats(context.Context, *GetSnippetStatsRequest) (*GetSnippetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnippetStats not implemented")
+}
+func (UnimplementedSnippetboxServer) CreateSnippetComment(context.Context, *CreateSnippetCommentRequest) (*CreateSnippetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnippetComment not implemented")
+}
+func (UnimplementedSnippetboxServer) ListSnippetComments(context.Context, *ListSnippetCommentsRequest) (*ListSnippetCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnippetComments not implemented")
+}
+func (UnimplementedSnippetboxServer) UpdateSnippetComment(context.Context, *UpdateSnippetCommentRequest) (*UpdateSnippetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSnippetComment not implemented")
+}
+func (UnimplementedSnippetboxServer) DeleteSnippetComment(context.Context, *DeleteSnippetCommentRequest) (*DeleteSnippetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnippetComment not implemented")
+}
+func (UnimplementedSnippetboxServer) mustEmbedUnimplementedSnippetboxServer() {}
+
+// UnsafeSnippetboxServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SnippetboxServer will
+// result in compilation errors.
+type UnsafeSnippetboxServer interface {
 	mustEmbedUnimplementedSnippetboxServer()
 }
 
-// UnimplementedSnippetboxServer must be embedded to have forward compatible implementations.
-type UnimplementedSnippetboxServer struct {
+func RegisterSnippetboxServer(s grpc.ServiceRegistrar, srv SnippetboxServer) {
+	s.RegisterService(&Snippetbox_ServiceDesc, srv)
+}
+
+func _Snippetbox_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListTaskQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListTaskQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListTaskQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListTaskQueues(ctx, req.(*ListTaskQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RetryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RetryTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RetryTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RetryTask(ctx, req.(*RetryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_PauseTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).PauseTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_PauseTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).PauseTaskQueue(ctx, req.(*PauseTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ResumeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ResumeTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ResumeTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ResumeTaskQueue(ctx, req.(*ResumeTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ImportSnippets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSnippetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ImportSnippets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ImportSnippets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ImportSnippets(ctx, req.(*ImportSnippetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_GetSnippetImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnippetImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).GetSnippetImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_GetSnippetImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).GetSnippetImport(ctx, req.(*GetSnippetImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RequestExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RequestExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RequestExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RequestExport(ctx, req.(*RequestExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).GetExport(ctx, req.(*GetExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_SearchAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).SearchAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_SearchAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).SearchAuditEvents(ctx, req.(*SearchAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListOrganizationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListOrganizationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_InviteOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).InviteOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_InviteOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).InviteOrganizationMember(ctx, req.(*InviteOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListOrganizationInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListOrganizationInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListOrganizationInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListOrganizationInvitations(ctx, req.(*ListOrganizationInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RespondOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondOrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RespondOrganizationInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RespondOrganizationInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RespondOrganizationInvitation(ctx, req.(*RespondOrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_UpdateOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).UpdateOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_UpdateOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).UpdateOrganizationMember(ctx, req.(*UpdateOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_TransferAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).TransferAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_TransferAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).TransferAccount(ctx, req.(*TransferAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RenameCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_MoveCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).MoveCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_MoveCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).MoveCollection(ctx, req.(*MoveCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedSnippetboxServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _Snippetbox_AddCollectionSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).AddCollectionSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_AddCollectionSnippet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).AddCollectionSnippet(ctx, req.(*AddCollectionSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSnippetboxServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _Snippetbox_RemoveCollectionSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).RemoveCollectionSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_RemoveCollectionSnippet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).RemoveCollectionSnippet(ctx, req.(*RemoveCollectionSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSnippetboxServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _Snippetbox_ReorderCollectionSnippets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionSnippetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ReorderCollectionSnippets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ReorderCollectionSnippets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ReorderCollectionSnippets(ctx, req.(*ReorderCollectionSnippetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSnippetboxServer) mustEmbedUnimplementedSnippetboxServer() {}
 
-// UnsafeSnippetboxServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SnippetboxServer will
-// result in compilation errors.
-type UnsafeSnippetboxServer interface {
-	mustEmbedUnimplementedSnippetboxServer()
+func _Snippetbox_StarSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).StarSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_StarSnippet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).StarSnippet(ctx, req.(*StarSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSnippetboxServer(s grpc.ServiceRegistrar, srv SnippetboxServer) {
-	s.RegisterService(&Snippetbox_ServiceDesc, srv)
+func _Snippetbox_UnstarSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).UnstarSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_UnstarSnippet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).UnstarSnippet(ctx, req.(*UnstarSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Snippetbox_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Snippetbox_ListStarredSnippets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStarredSnippetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnippetboxServer).CreateUser(ctx, in)
+		return srv.(SnippetboxServer).ListStarredSnippets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Snippetbox_CreateUser_FullMethodName,
+		FullMethod: Snippetbox_ListStarredSnippets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnippetboxServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(SnippetboxServer).ListStarredSnippets(ctx, req.(*ListStarredSnippetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Snippetbox_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Snippetbox_ForkSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkSnippetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnippetboxServer).UpdateUser(ctx, in)
+		return srv.(SnippetboxServer).ForkSnippet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Snippetbox_UpdateUser_FullMethodName,
+		FullMethod: Snippetbox_ForkSnippet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnippetboxServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(SnippetboxServer).ForkSnippet(ctx, req.(*ForkSnippetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Snippetbox_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _Snippetbox_ListSnippetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnippetForksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnippetboxServer).LoginUser(ctx, in)
+		return srv.(SnippetboxServer).ListSnippetForks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Snippetbox_LoginUser_FullMethodName,
+		FullMethod: Snippetbox_ListSnippetForks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnippetboxServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(SnippetboxServer).ListSnippetForks(ctx, req.(*ListSnippetForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_GetSnippetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnippetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).GetSnippetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_GetSnippetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).GetSnippetStats(ctx, req.(*GetSnippetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_CreateSnippetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnippetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).CreateSnippetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_CreateSnippetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).CreateSnippetComment(ctx, req.(*CreateSnippetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_ListSnippetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnippetCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).ListSnippetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_ListSnippetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).ListSnippetComments(ctx, req.(*ListSnippetCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_UpdateSnippetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSnippetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).UpdateSnippetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_UpdateSnippetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).UpdateSnippetComment(ctx, req.(*UpdateSnippetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snippetbox_DeleteSnippetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnippetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnippetboxServer).DeleteSnippetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Snippetbox_DeleteSnippetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnippetboxServer).DeleteSnippetComment(ctx, req.(*DeleteSnippetCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -177,6 +1695,190 @@ var Snippetbox_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginUser",
 			Handler:    _Snippetbox_LoginUser_Handler,
 		},
+		{
+			MethodName: "ListTaskQueues",
+			Handler:    _Snippetbox_ListTaskQueues_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _Snippetbox_ListTasks_Handler,
+		},
+		{
+			MethodName: "RetryTask",
+			Handler:    _Snippetbox_RetryTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _Snippetbox_DeleteTask_Handler,
+		},
+		{
+			MethodName: "PauseTaskQueue",
+			Handler:    _Snippetbox_PauseTaskQueue_Handler,
+		},
+		{
+			MethodName: "ResumeTaskQueue",
+			Handler:    _Snippetbox_ResumeTaskQueue_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Snippetbox_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Snippetbox_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Snippetbox_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Snippetbox_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Snippetbox_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _Snippetbox_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "ImportSnippets",
+			Handler:    _Snippetbox_ImportSnippets_Handler,
+		},
+		{
+			MethodName: "GetSnippetImport",
+			Handler:    _Snippetbox_GetSnippetImport_Handler,
+		},
+		{
+			MethodName: "RequestExport",
+			Handler:    _Snippetbox_RequestExport_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _Snippetbox_GetExport_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Snippetbox_RevokeSession_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Snippetbox_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "SearchAuditEvents",
+			Handler:    _Snippetbox_SearchAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _Snippetbox_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _Snippetbox_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "ListOrganizationMembers",
+			Handler:    _Snippetbox_ListOrganizationMembers_Handler,
+		},
+		{
+			MethodName: "InviteOrganizationMember",
+			Handler:    _Snippetbox_InviteOrganizationMember_Handler,
+		},
+		{
+			MethodName: "ListOrganizationInvitations",
+			Handler:    _Snippetbox_ListOrganizationInvitations_Handler,
+		},
+		{
+			MethodName: "RespondOrganizationInvitation",
+			Handler:    _Snippetbox_RespondOrganizationInvitation_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationMember",
+			Handler:    _Snippetbox_UpdateOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _Snippetbox_RemoveOrganizationMember_Handler,
+		},
+		{
+			MethodName: "TransferAccount",
+			Handler:    _Snippetbox_TransferAccount_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Snippetbox_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _Snippetbox_ListCollections_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _Snippetbox_RenameCollection_Handler,
+		},
+		{
+			MethodName: "MoveCollection",
+			Handler:    _Snippetbox_MoveCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _Snippetbox_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddCollectionSnippet",
+			Handler:    _Snippetbox_AddCollectionSnippet_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionSnippet",
+			Handler:    _Snippetbox_RemoveCollectionSnippet_Handler,
+		},
+		{
+			MethodName: "ReorderCollectionSnippets",
+			Handler:    _Snippetbox_ReorderCollectionSnippets_Handler,
+		},
+		{
+			MethodName: "StarSnippet",
+			Handler:    _Snippetbox_StarSnippet_Handler,
+		},
+		{
+			MethodName: "UnstarSnippet",
+			Handler:    _Snippetbox_UnstarSnippet_Handler,
+		},
+		{
+			MethodName: "ListStarredSnippets",
+			Handler:    _Snippetbox_ListStarredSnippets_Handler,
+		},
+		{
+			MethodName: "ForkSnippet",
+			Handler:    _Snippetbox_ForkSnippet_Handler,
+		},
+		{
+			MethodName: "ListSnippetForks",
+			Handler:    _Snippetbox_ListSnippetForks_Handler,
+		},
+		{
+			MethodName: "GetSnippetStats",
+			Handler:    _Snippetbox_GetSnippetStats_Handler,
+		},
+		{
+			MethodName: "CreateSnippetComment",
+			Handler:    _Snippetbox_CreateSnippetComment_Handler,
+		},
+		{
+			MethodName: "ListSnippetComments",
+			Handler:    _Snippetbox_ListSnippetComments_Handler,
+		},
+		{
+			MethodName: "UpdateSnippetComment",
+			Handler:    _Snippetbox_UpdateSnippetComment_Handler,
+		},
+		{
+			MethodName: "DeleteSnippetComment",
+			Handler:    _Snippetbox_DeleteSnippetComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_snippetbox.proto",
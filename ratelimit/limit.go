@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket: Burst calls at once, refilled at Rate calls per
+// second. A zero limit is disabled.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (limit Limit) Enabled() bool {
+	return limit.Rate > 0 && limit.Burst > 0
+}
+
+// Result is the state of a bucket after taking a token.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// when the next call is allowed, zero if it is allowed now
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets, it takes one token from the bucket of key.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// ParseLimit parses "rate:burst", e.g. "10:20" for 10 calls per second with
+// bursts of 20. An empty string is a disabled limit.
+func ParseLimit(s string) (Limit, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Limit{}, nil
+	}
+
+	rate, burst, ok := strings.Cut(s, ":")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q, want rate:burst", s)
+	}
+
+	var limit Limit
+	var err error
+	limit.Rate, err = strconv.ParseFloat(rate, 64)
+	if err != nil || limit.Rate < 0 {
+		return Limit{}, fmt.Errorf("invalid rate in limit %q", s)
+	}
+	limit.Burst, err = strconv.Atoi(burst)
+	if err != nil || limit.Burst < 0 {
+		return Limit{}, fmt.Errorf("invalid burst in limit %q", s)
+	}
+
+	return limit, nil
+}
+
+// ParseMethodLimits parses comma separated "Method=rate:burst" pairs, the
+// methods are RPC names like LoginUser.
+func ParseMethodLimits(s string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(pair, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method limit %q, want Method=rate:burst", pair)
+		}
+
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return nil, err
+		}
+		limits[strings.TrimSpace(method)] = limit
+	}
+
+	return limits, nil
+}
+
+// refill returns the tokens of a bucket after elapsed time, taking one if
+// there is one.
+func refill(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	tokens = math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+
+	return tokens, newResult(allowed, tokens, limit)
+}
+
+// newResult describes a bucket with tokens left after a take.
+func newResult(allowed bool, tokens float64, limit Limit) Result {
+	result := Result{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: int(tokens),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Policy sets the limits of a Limiter, disabled limits are skipped.
+type Policy struct {
+	// per authenticated user over all methods
+	User Limit
+	// per client IP over all methods
+	IP Limit
+	// per caller of one method, keyed by RPC name like LoginUser. The caller
+	// is the user when authenticated, otherwise the IP.
+	Methods map[string]Limit
+}
+
+// Limiter applies a policy to calls.
+type Limiter struct {
+	store  Store
+	policy Policy
+}
+
+func NewLimiter(store Store, policy Policy) *Limiter {
+	return &Limiter{
+		store:  store,
+		policy: policy,
+	}
+}
+
+// Allow takes a token from every bucket the call counts against. The result
+// is the most restrictive one: denied if any bucket is empty, with the
+// longest wait and the smallest remaining quota.
+func (limiter *Limiter) Allow(ctx context.Context, method, user, ip string) (Result, error) {
+	type bucket struct {
+		key   string
+		limit Limit
+	}
+
+	caller := "ip:" + ip
+	buckets := []bucket{
+		{key: caller, limit: limiter.policy.IP},
+	}
+	if user != "" {
+		caller = "user:" + user
+		buckets = append(buckets, bucket{key: caller, limit: limiter.policy.User})
+	}
+	buckets = append(buckets, bucket{key: "method:" + method + ":" + caller, limit: limiter.policy.Methods[method]})
+
+	result := Result{Allowed: true}
+	checked := false
+	for _, b := range buckets {
+		if !b.limit.Enabled() {
+			continue
+		}
+
+		r, err := limiter.store.Take(ctx, b.key, b.limit)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if !checked || r.Remaining < result.Remaining {
+			result.Limit = r.Limit
+			result.Remaining = r.Remaining
+		}
+		if !r.Allowed {
+			result.Allowed = false
+			if r.RetryAfter > result.RetryAfter {
+				result.RetryAfter = r.RetryAfter
+			}
+		}
+		checked = true
+	}
+
+	return result, nil
+}
+
+// RetryAfterSeconds rounds the wait up to whole seconds for the Retry-After
+// header.
+func (result Result) RetryAfterSeconds() int {
+	return int((result.RetryAfter + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("0.5:10")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Rate: 0.5, Burst: 10}, limit)
+	require.True(t, limit.Enabled())
+
+	limit, err = ParseLimit("")
+	require.NoError(t, err)
+	require.False(t, limit.Enabled())
+
+	for _, s := range []string{"10", "a:1", "1:b", "-1:2"} {
+		_, err = ParseLimit(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseMethodLimits(t *testing.T) {
+	limits, err := ParseMethodLimits("LoginUser=0.2:5, CreateUser=0.1:3")
+	require.NoError(t, err)
+	require.Equal(t, map[string]Limit{
+		"LoginUser":  {Rate: 0.2, Burst: 5},
+		"CreateUser": {Rate: 0.1, Burst: 3},
+	}, limits)
+
+	limits, err = ParseMethodLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	_, err = ParseMethodLimits("LoginUser")
+	require.Error(t, err)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 1, Burst: 2}
+
+	for i := 1; i >= 0; i-- {
+		result, err := store.Take(context.Background(), "key", limit)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+		require.Equal(t, 2, result.Limit)
+		require.Equal(t, i, result.Remaining)
+	}
+
+	result, err := store.Take(context.Background(), "key", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Zero(t, result.Remaining)
+	require.InDelta(t, time.Second, result.RetryAfter, float64(50*time.Millisecond))
+	require.Equal(t, 1, result.RetryAfterSeconds())
+
+	// other keys have their own bucket
+	result, err = store.Take(context.Background(), "other", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), Policy{
+		User: Limit{Rate: 1, Burst: 5},
+		IP:   Limit{Rate: 1, Burst: 3},
+		Methods: map[string]Limit{
+			"LoginUser": {Rate: 1, Burst: 1},
+		},
+	})
+	ctx := context.Background()
+
+	// the method limit is the smallest
+	result, err := limiter.Allow(ctx, "LoginUser", "", "10.0.0.1")
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Equal(t, 1, result.Limit)
+	require.Zero(t, result.Remaining)
+
+	result, err = limiter.Allow(ctx, "LoginUser", "", "10.0.0.1")
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Greater(t, result.RetryAfter, time.Duration(0))
+
+	// another IP has its own buckets
+	result, err = limiter.Allow(ctx, "LoginUser", "", "10.0.0.2")
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	// the IP bucket is shared by all methods and users
+	for i := 0; i < 2; i++ {
+		result, err = limiter.Allow(ctx, "GetExport", "alice", "10.0.0.2")
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+	result, err = limiter.Allow(ctx, "GetExport", "bob", "10.0.0.2")
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+}
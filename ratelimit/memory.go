@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// buckets which are full again are dropped this often
+const memoryPruneInterval = time.Minute
+
+// MemoryStore keeps the buckets in process memory, each instance limits on
+// its own.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastPrune time.Time
+}
+
+type memoryBucket struct {
+	tokens float64
+	last   time.Time
+	limit  Limit
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:   make(map[string]*memoryBucket),
+		lastPrune: time.Now(),
+	}
+}
+
+func (store *MemoryStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := time.Now()
+	store.prune(now)
+
+	bucket, ok := store.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{
+			tokens: float64(limit.Burst),
+			last:   now,
+		}
+		store.buckets[key] = bucket
+	}
+
+	var result Result
+	bucket.tokens, result = refill(bucket.tokens, now.Sub(bucket.last), limit)
+	bucket.last = now
+	bucket.limit = limit
+
+	return result, nil
+}
+
+// prune must be called with the lock held.
+func (store *MemoryStore) prune(now time.Time) {
+	if now.Sub(store.lastPrune) < memoryPruneInterval {
+		return
+	}
+	store.lastPrune = now
+
+	for key, bucket := range store.buckets {
+		refilled := bucket.tokens + now.Sub(bucket.last).Seconds()*bucket.limit.Rate
+		if refilled >= float64(bucket.limit.Burst) {
+			delete(store.buckets, key)
+		}
+	}
+}
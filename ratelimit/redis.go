@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// takeScript refills and takes from a bucket atomically, it returns whether
+// the call is allowed and the tokens left. The clock of Redis is used, so
+// instances with skewed clocks share the same buckets.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(state[1]) or burst
+local last = tonumber(state[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - last) * rate)
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "last", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+
+return {allowed, tostring(tokens)}
+`)
+
+const redisKeyPrefix = "snippetbox:ratelimit:"
+
+// RedisStore shares the buckets between instances. When Redis fails, calls
+// are limited by the fallback store of this instance instead.
+type RedisStore struct {
+	client   redis.UniversalClient
+	fallback Store
+}
+
+func NewRedisStore(client redis.UniversalClient, fallback Store) *RedisStore {
+	return &RedisStore{
+		client:   client,
+		fallback: fallback,
+	}
+}
+
+func (store *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	result, err := store.take(ctx, key, limit)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("rate limit store failed, using fallback")
+		return store.fallback.Take(ctx, key, limit)
+	}
+	return result, nil
+}
+
+func (store *RedisStore) take(ctx context.Context, key string, limit Limit) (Result, error) {
+	values, err := takeScript.Run(ctx, store.client, []string{redisKeyPrefix + key},
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64), limit.Burst).Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("unexpected rate limit script result: %v", values)
+	}
+
+	allowed, _ := values[0].(int64)
+	tokensValue, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensValue, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("unexpected rate limit tokens: %w", err)
+	}
+
+	return newResult(allowed == 1, tokens, limit), nil
+}
@@ -0,0 +1,48 @@
+package requestid
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Header carries the id of an HTTP request and response
+	Header = "X-Request-ID"
+	// MetadataKey carries the id in gRPC metadata, keys are lower case
+	MetadataKey = "x-request-id"
+)
+
+type contextKey struct{}
+
+// ids sent by clients are kept when they are short and safe to log
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+func New() string {
+	return uuid.NewString()
+}
+
+// Accept returns id if a client sent a valid one, otherwise a new id.
+func Accept(id string) string {
+	if validID.MatchString(id) {
+		return id
+	}
+	return New()
+}
+
+// NewContext stores the id and a logger which adds it to every line, use
+// log.Ctx(ctx) to log with it.
+func NewContext(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, contextKey{}, id)
+
+	logger := log.Ctx(ctx).With().Str("request_id", id).Logger()
+	return logger.WithContext(ctx)
+}
+
+// FromContext returns the id of the request, or an empty string.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,37 @@
+package requestid
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccept(t *testing.T) {
+	require.Equal(t, "abc-123", Accept("abc-123"))
+	require.Equal(t, "7f9c2ba4-e88f-11eb.9ba3:0242ac130003", Accept("7f9c2ba4-e88f-11eb.9ba3:0242ac130003"))
+
+	for _, id := range []string{"", "has space", "new\nline", strings.Repeat("a", 129)} {
+		accepted := Accept(id)
+		require.NotEqual(t, id, accepted)
+		require.Len(t, accepted, 36)
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	var buf bytes.Buffer
+	base := zerolog.New(&buf)
+	ctx := base.WithContext(context.Background())
+
+	require.Empty(t, FromContext(ctx))
+
+	ctx = NewContext(ctx, "abc-123")
+	require.Equal(t, "abc-123", FromContext(ctx))
+
+	log.Ctx(ctx).Info().Msg("hello")
+	require.Contains(t, buf.String(), `"request_id":"abc-123"`)
+}
@@ -0,0 +1,218 @@
+package tlsconfig
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// the files are checked for changes at most this often
+const reloadCheckInterval = 5 * time.Second
+
+// Options configure the TLS of a listener, it is plain TCP without CertFile.
+type Options struct {
+	CertFile string
+	KeyFile  string
+	// enables mutual TLS, client certificates must be signed by these CAs
+	ClientCAFile string
+	// without it a client certificate is verified only when one is sent, so
+	// users with tokens can still connect
+	RequireClientCert bool
+	// "1.2" or "1.3"
+	MinVersion string
+}
+
+func (opts Options) Enabled() bool {
+	return opts.CertFile != ""
+}
+
+// Reloader serves the certificates of the options and reloads them when the
+// files change, so certificates are renewed without a restart.
+type Reloader struct {
+	opts       Options
+	minVersion uint16
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+	lastCheck time.Time
+}
+
+// NewReloader loads the files once, a broken file is an error here but is
+// only logged on reload, the previous certificates stay in use then.
+func NewReloader(opts Options) (*Reloader, error) {
+	minVersion, err := parseVersion(opts.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader := &Reloader{
+		opts:       opts,
+		minVersion: minVersion,
+	}
+
+	if err := reloader.load(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+func parseVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported minimum TLS version %q, use 1.2 or 1.3", version)
+	}
+}
+
+func (reloader *Reloader) files() []string {
+	files := []string{reloader.opts.CertFile, reloader.opts.KeyFile}
+	if reloader.opts.ClientCAFile != "" {
+		files = append(files, reloader.opts.ClientCAFile)
+	}
+	return files
+}
+
+// load must be called with the lock held or before the reloader is shared.
+func (reloader *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range reloader.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("cannot read TLS file: %w", err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(reloader.opts.CertFile, reloader.opts.KeyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load server certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if reloader.opts.ClientCAFile != "" {
+		pem, err := os.ReadFile(reloader.opts.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("cannot read client CA: %w", err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in client CA file %s", reloader.opts.ClientCAFile)
+		}
+	}
+
+	reloader.cert = &cert
+	reloader.clientCAs = clientCAs
+	reloader.modTimes = modTimes
+	reloader.lastCheck = time.Now()
+
+	return nil
+}
+
+// reloadIfChanged must be called with the lock held.
+func (reloader *Reloader) reloadIfChanged() {
+	if time.Since(reloader.lastCheck) < reloadCheckInterval {
+		return
+	}
+	reloader.lastCheck = time.Now()
+
+	changed := false
+	for _, file := range reloader.files() {
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().Equal(reloader.modTimes[file]) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return
+	}
+
+	if err := reloader.load(); err != nil {
+		log.Error().Err(err).Msg("failed to reload TLS certificates, keeping the previous ones")
+		return
+	}
+	log.Info().Msg("reloaded TLS certificates")
+}
+
+// ServerConfig returns the TLS config of a listener, every handshake uses the
+// current certificates. nextProtos are the ALPN protocols of the listener.
+func (reloader *Reloader) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: reloader.minVersion,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			reloader.mu.Lock()
+			defer reloader.mu.Unlock()
+
+			reloader.reloadIfChanged()
+
+			config := &tls.Config{
+				MinVersion:   reloader.minVersion,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*reloader.cert},
+			}
+			if reloader.clientCAs != nil {
+				config.ClientCAs = reloader.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+				if reloader.opts.RequireClientCert {
+					config.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// LoopbackClientConfig is the TLS config of connections of this process to
+// its own server, like the gateway's. It trusts exactly the current server
+// certificate, whoever issued it.
+func (reloader *Reloader) LoopbackClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: reloader.minVersion,
+		// the certificate is checked by VerifyPeerCertificate instead
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			reloader.mu.Lock()
+			cert := reloader.cert
+			reloader.mu.Unlock()
+
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], cert.Certificate[0]) {
+				return errors.New("server certificate is not the loaded certificate")
+			}
+			return nil
+		},
+	}
+}
+
+// ClientIdentity returns the name of the verified client certificate of a
+// connection, the common name or else the first DNS name. It is empty when
+// the client sent no certificate.
+func ClientIdentity(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	leaf := state.VerifiedChains[0][0]
+	if leaf.Subject.CommonName != "" {
+		return leaf.Subject.CommonName
+	}
+	if len(leaf.DNSNames) > 0 {
+		return leaf.DNSNames[0]
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate writes a self-signed certificate and its key to dir.
+func writeCertificate(t *testing.T, dir string, commonName string) (certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certFile, keyFile
+}
+
+func serverCommonName(t *testing.T, config *tls.Config) string {
+	clientConfig, err := config.GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.Len(t, clientConfig.Certificates, 1)
+
+	leaf, err := x509.ParseCertificate(clientConfig.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir, "first")
+
+	reloader, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.3"})
+	require.NoError(t, err)
+
+	config := reloader.ServerConfig("h2")
+	require.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	require.Equal(t, "first", serverCommonName(t, config))
+
+	writeCertificate(t, dir, "second")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+
+	// not checked again before the interval
+	require.Equal(t, "first", serverCommonName(t, config))
+
+	reloader.lastCheck = time.Now().Add(-reloadCheckInterval)
+	require.Equal(t, "second", serverCommonName(t, config))
+
+	// a broken file keeps the previous certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("broken"), 0600))
+	reloader.lastCheck = time.Now().Add(-reloadCheckInterval)
+	require.Equal(t, "second", serverCommonName(t, config))
+}
+
+func TestReloaderClientCA(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir(), "server")
+	caFile, _ := writeCertificate(t, t.TempDir(), "ca")
+
+	testCases := []struct {
+		name       string
+		require    bool
+		clientAuth tls.ClientAuthType
+	}{
+		{name: "Optional", require: false, clientAuth: tls.VerifyClientCertIfGiven},
+		{name: "Required", require: true, clientAuth: tls.RequireAndVerifyClientCert},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			reloader, err := NewReloader(Options{
+				CertFile:          certFile,
+				KeyFile:           keyFile,
+				ClientCAFile:      caFile,
+				RequireClientCert: tc.require,
+			})
+			require.NoError(t, err)
+
+			clientConfig, err := reloader.ServerConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+			require.NoError(t, err)
+			require.Equal(t, tc.clientAuth, clientConfig.ClientAuth)
+			require.NotNil(t, clientConfig.ClientCAs)
+			require.Equal(t, uint16(tls.VersionTLS12), clientConfig.MinVersion)
+		})
+	}
+}
+
+func TestNewReloaderErrors(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir(), "server")
+
+	_, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.1"})
+	require.Error(t, err)
+
+	_, err = NewReloader(Options{CertFile: certFile, KeyFile: filepath.Join(t.TempDir(), "missing.pem")})
+	require.Error(t, err)
+
+	badCA := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(badCA, []byte("not a certificate"), 0600))
+	_, err = NewReloader(Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: badCA})
+	require.Error(t, err)
+}
+
+func TestClientIdentity(t *testing.T) {
+	require.Empty(t, ClientIdentity(nil))
+	require.Empty(t, ClientIdentity(&tls.ConnectionState{}))
+
+	withName := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	state := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{withName}}}
+	require.Equal(t, "billing", ClientIdentity(state))
+
+	withDNS := &x509.Certificate{DNSNames: []string{"reports.internal"}}
+	state = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{withDNS}}}
+	require.Equal(t, "reports.internal", ClientIdentity(state))
+}
+
+func TestLoopbackClientConfig(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir(), "server")
+	otherCertFile, otherKeyFile := writeCertificate(t, t.TempDir(), "other")
+
+	reloader, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	other, err := NewReloader(Options{CertFile: otherCertFile, KeyFile: otherKeyFile})
+	require.NoError(t, err)
+
+	verify := reloader.LoopbackClientConfig().VerifyPeerCertificate
+	require.NoError(t, verify(reloader.cert.Certificate, nil))
+	require.Error(t, verify(other.cert.Certificate, nil))
+	require.Error(t, verify(nil, nil))
+}
@@ -22,8 +22,8 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 	return &JWTMaker{secretKey}, nil
 }
 
-func (maker *JWTMaker) CreateToken(name string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(name, duration)
+func (maker *JWTMaker) CreateToken(name string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(name, role, duration)
 	if err != nil {
 		return "", payload, err
 	}
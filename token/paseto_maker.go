@@ -25,8 +25,8 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-func (maker *PasetoMaker) CreateToken(name string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(name, duration)
+func (maker *PasetoMaker) CreateToken(name string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(name, role, duration)
 	if err != nil {
 		return "", payload, err
 	}
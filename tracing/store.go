@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/scipiia/snippetbox/tracing"
+
+// ObserveStore is a db.StoreObserver which runs every store method in a
+// client span. A missing row is not recorded as an error.
+func ObserveStore(ctx context.Context, method string) (context.Context, func(err error)) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "db.Store/"+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation", method),
+		),
+	)
+
+	return ctx, func(err error) {
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestObserveStore(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	testCases := []struct {
+		name   string
+		err    error
+		status codes.Code
+	}{
+		{name: "OK", err: nil, status: codes.Unset},
+		{name: "NoRows", err: sql.ErrNoRows, status: codes.Unset},
+		{name: "Error", err: errors.New("connection reset"), status: codes.Error},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+			_, done := ObserveStore(ctx, "GetUser")
+			done(tc.err)
+			parent.End()
+
+			spans := recorder.Ended()
+			span := spans[len(spans)-2]
+			require.Equal(t, "db.Store/GetUser", span.Name())
+			require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			require.Equal(t, tc.status, span.Status().Code)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package util
+
+import "fmt"
+
+// Actions recorded in the audit log
+const (
+	AuditUserLogin          = "user.login"
+	AuditUserLoginFailed    = "user.login_failed"
+	AuditUserUpdated        = "user.updated"
+	AuditUserPasswordChange = "user.password_changed"
+	AuditSessionRevoked     = "session.revoked"
+	AuditAccountCreated     = "account.created"
+	AuditAccountUpdated     = "account.updated"
+	AuditAccountDeleted     = "account.deleted"
+	AuditSnippetCreated     = "snippet.created"
+	AuditSnippetUpdated     = "snippet.updated"
+	AuditSnippetDeleted     = "snippet.deleted"
+	AuditSnippetForked      = "snippet.forked"
+	AuditCommentDeleted     = "snippet.comment_deleted"
+	AuditEventsExported     = "audit.exported"
+	AuditAccountTransferred = "account.transferred"
+	AuditOrgCreated         = "org.created"
+	AuditOrgMemberInvited   = "org.member_invited"
+	AuditOrgMemberJoined    = "org.member_joined"
+	AuditOrgMemberUpdated   = "org.member_role_changed"
+	AuditOrgMemberRemoved   = "org.member_removed"
+)
+
+// AnonymousActor is recorded for failures of unauthenticated callers. No user
+// can have an empty name, so the events never show up as anyone's activity.
+const AnonymousActor = ""
+
+// AuditTarget names the resource of an audit event, e.g. snippet:42
+func AuditTarget(kind string, id interface{}) string {
+	return fmt.Sprintf("%s:%v", kind, id)
+}
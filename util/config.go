@@ -1,36 +1,297 @@
 package util
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+// the config file of the YAML layer, relative to the config path
+const defaultConfigFile = "app.yaml"
+
+// configDefaults is the lowest layer, settings without a safe default like
+// DB_SOURCE, TOKEN_SYMMETRIC_KEY and EXPORT_URL_SIGNING_KEY have to be
+// configured. TASK_BROKER memory keeps tasks in the process, queued and
+// retrying tasks are lost on restart, so it is refused in production.
+var configDefaults = map[string]interface{}{
+	"ENVIRONMENT":            "production",
+	"DB_DRIVER":              "postgres",
+	"AUTO_MIGRATE":           "true",
+	"TASK_BROKER":            "redis",
+	"REDIS_ADDRESS":          "0.0.0.0:6379",
+	"HTTP_SERVER_ADDRESS":    "0.0.0.0:8080",
+	"GRPC_SERVER_ADDRESS":    "0.0.0.0:9090",
+	"METRICS_SERVER_ADDRESS": "0.0.0.0:9091",
+	"ACCESS_TOKEN_DURATION":  "15m",
+	"REFRESH_TOKEN_DURATION": "24h",
+	"OUTBOX_RELAY_INTERVAL":  "1s",
+	"OUTBOX_RETENTION":       "24h",
+	"BLOB_STORAGE_DIR":       "./data/blobs",
+	"EXPORT_URL_DURATION":    "15m",
+	"EXPORT_RETENTION":       "168h",
+	"EXPORT_PURGE_INTERVAL":  "1h",
+	"TRACE_EXPORTER":         "none",
+	"TRACE_SAMPLE_RATIO":     "1",
+	"OTLP_ENDPOINT":          "localhost:4317",
+	"TLS_MIN_VERSION":        "1.2",
+	"CORS_ALLOWED_METHODS":   "GET,POST,PATCH,DELETE",
+	"CORS_ALLOWED_HEADERS":   "Authorization,Content-Type,X-Request-ID,X-CSRF-Token",
+	"CORS_MAX_AGE":           "10m",
+}
+
 type Config struct {
 	Environment          string        `mapstructure:"ENVIRONMENT"`
 	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
+	DBSource             string        `mapstructure:"DB_SOURCE" secret:"true"`
+	DBPassword           string        `mapstructure:"DB_PASSWORD" secret:"true"`
 	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
+	AutoMigrate          bool          `mapstructure:"AUTO_MIGRATE"`
+	TaskBroker           string        `mapstructure:"TASK_BROKER"`
 	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
 	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
 	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKye    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	SinglePort           bool          `mapstructure:"SINGLE_PORT"`
+	GatewayGRPCClient    bool          `mapstructure:"GATEWAY_GRPC_CLIENT"`
+	MetricsServerAddress string        `mapstructure:"METRICS_SERVER_ADDRESS"`
+	TokenSymmetricKye    string        `mapstructure:"TOKEN_SYMMETRIC_KEY" secret:"true"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	OutboxRelayInterval  time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	OutboxRetention      time.Duration `mapstructure:"OUTBOX_RETENTION"`
+	BlobStorageDir       string        `mapstructure:"BLOB_STORAGE_DIR"`
+	ExportURLDuration    time.Duration `mapstructure:"EXPORT_URL_DURATION"`
+	ExportURLSigningKey  string        `mapstructure:"EXPORT_URL_SIGNING_KEY" secret:"true"`
+	ExportRetention      time.Duration `mapstructure:"EXPORT_RETENTION"`
+	ExportPurgeInterval  time.Duration `mapstructure:"EXPORT_PURGE_INTERVAL"`
+	TraceExporter        string        `mapstructure:"TRACE_EXPORTER"`
+	TraceSampleRatio     float64       `mapstructure:"TRACE_SAMPLE_RATIO"`
+	OTLPEndpoint         string        `mapstructure:"OTLP_ENDPOINT"`
+	OTLPInsecure         bool          `mapstructure:"OTLP_INSECURE"`
+	RateLimitUser        string        `mapstructure:"RATE_LIMIT_USER"`
+	RateLimitIP          string        `mapstructure:"RATE_LIMIT_IP"`
+	RateLimitMethods     string        `mapstructure:"RATE_LIMIT_METHODS"`
+	TLSCertFile          string        `mapstructure:"TLS_CERT_FILE"`
+	TLSKeyFile           string        `mapstructure:"TLS_KEY_FILE"`
+	TLSClientCAFile      string        `mapstructure:"TLS_CLIENT_CA_FILE"`
+	TLSRequireClientCert bool          `mapstructure:"TLS_REQUIRE_CLIENT_CERT"`
+	TLSMinVersion        string        `mapstructure:"TLS_MIN_VERSION"`
+	CORSAllowedOrigins   []string      `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAllowedMethods   []string      `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders   []string      `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials bool          `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAge           time.Duration `mapstructure:"CORS_MAX_AGE"`
+	HSTSMaxAge           time.Duration `mapstructure:"HSTS_MAX_AGE"`
+	AuthCookieName       string        `mapstructure:"AUTH_COOKIE_NAME"`
 }
 
+// LiadConfig loads the config of path without command line flags.
 func LiadConfig(path string) (config Config, err error) {
-	viper.AddConfigPath(path)
-	viper.SetConfigName("app")
-	viper.SetConfigType("env")
+	return LoadConfig(path, nil)
+}
+
+// LoadConfig loads the config in layers, each overriding the one before:
+// the defaults, the YAML file (app.yaml in path or --config/CONFIG_FILE),
+// app.env in path, the environment and the flags in args, like
+// --http-server-address=:8081. A secret like TOKEN_SYMMETRIC_KEY can be read
+// from the file named by TOKEN_SYMMETRIC_KEY_FILE instead, which wins.
+//
+// The config is not validated, see Validate.
+func LoadConfig(path string, args []string) (config Config, err error) {
+	v := viper.New()
+
+	flags := pflag.NewFlagSet("snippetbox", pflag.ContinueOnError)
+	configFile := flags.String("config", "", "YAML config file")
+	for _, key := range configKeys() {
+		flags.String(flagName(key), "", "overrides "+key)
+	}
+	if err = flags.Parse(args); err != nil {
+		return
+	}
+
+	for _, key := range configKeys() {
+		if value, ok := configDefaults[key]; ok {
+			v.SetDefault(key, value)
+		}
+		if err = v.BindEnv(key); err != nil {
+			return
+		}
+		if isSecret(key) {
+			if err = v.BindEnv(key + "_FILE"); err != nil {
+				return
+			}
+		}
+	}
+
+	if *configFile == "" {
+		*configFile = os.Getenv("CONFIG_FILE")
+	}
+	if err = readConfigFile(v, path, *configFile); err != nil {
+		return
+	}
 
-	viper.AutomaticEnv()
+	flags.Visit(func(flag *pflag.Flag) {
+		if flag.Name != "config" {
+			v.Set(flagKey(flag.Name), flag.Value.String())
+		}
+	})
 
-	err = viper.ReadInConfig()
+	for _, key := range configKeys() {
+		if !isSecret(key) {
+			continue
+		}
+		secretFile := v.GetString(key + "_FILE")
+		if secretFile == "" {
+			continue
+		}
+
+		secret, readErr := os.ReadFile(secretFile)
+		if readErr != nil {
+			err = fmt.Errorf("cannot read %s_FILE: %w", key, readErr)
+			return
+		}
+		v.Set(key, strings.TrimSpace(string(secret)))
+	}
+
+	err = v.Unmarshal(&config)
 	if err != nil {
 		return
 	}
 
-	err = viper.Unmarshal(&config)
+	err = config.applyDBPassword()
 	return
 }
+
+// readConfigFile merges the YAML file and then app.env, an explicit YAML
+// file must exist, the default ones are optional.
+func readConfigFile(v *viper.Viper, path string, configFile string) error {
+	yamlFile := configFile
+	if yamlFile == "" {
+		yamlFile = filepath.Join(path, defaultConfigFile)
+	}
+
+	if err := mergeConfigFile(v, yamlFile, "yaml"); err != nil {
+		if configFile != "" || !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	err := mergeConfigFile(v, filepath.Join(path, "app.env"), "env")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func mergeConfigFile(v *viper.Viper, file string, configType string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	v.SetConfigType(configType)
+	if err := v.MergeConfig(f); err != nil {
+		return fmt.Errorf("cannot read config file %s: %w", file, err)
+	}
+	return nil
+}
+
+// applyDBPassword puts DB_PASSWORD in the DB_SOURCE URL, so the URL can be
+// configured without the secret.
+func (config *Config) applyDBPassword() error {
+	if config.DBPassword == "" {
+		return nil
+	}
+
+	source, err := url.Parse(config.DBSource)
+	if err != nil || source.User == nil {
+		return fmt.Errorf("DB_PASSWORD needs a DB_SOURCE URL with a user")
+	}
+
+	source.User = url.UserPassword(source.User.Username(), config.DBPassword)
+	config.DBSource = source.String()
+	return nil
+}
+
+// configKeys are the keys of all settings, in the order of Config.
+func configKeys() []string {
+	configType := reflect.TypeOf(Config{})
+	keys := make([]string, 0, configType.NumField())
+	for i := 0; i < configType.NumField(); i++ {
+		keys = append(keys, configType.Field(i).Tag.Get("mapstructure"))
+	}
+	return keys
+}
+
+func isSecret(key string) bool {
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		if field.Tag.Get("mapstructure") == key {
+			return field.Tag.Get("secret") == "true"
+		}
+	}
+	return false
+}
+
+// HTTP_SERVER_ADDRESS is set by --http-server-address
+func flagName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "_", "-")
+}
+
+func flagKey(name string) string {
+	return strings.ReplaceAll(strings.ToUpper(name), "-", "_")
+}
+
+const redacted = "[REDACTED]"
+
+// Print writes the settings as KEY=value lines, with the secrets redacted.
+func (config Config) Print(w io.Writer) error {
+	value := reflect.ValueOf(config)
+	configType := value.Type()
+
+	lines := make([]string, 0, configType.NumField())
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		key := field.Tag.Get("mapstructure")
+
+		var setting string
+		switch fieldValue := value.Field(i).Interface().(type) {
+		case []string:
+			setting = strings.Join(fieldValue, ",")
+		default:
+			setting = fmt.Sprint(fieldValue)
+		}
+
+		if field.Tag.Get("secret") == "true" && setting != "" {
+			setting = redact(key, setting)
+		}
+		lines = append(lines, key+"="+setting)
+	}
+	sort.Strings(lines)
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// redact hides a secret, URLs keep everything but the password.
+func redact(key string, setting string) string {
+	if key == "DB_SOURCE" {
+		if source, err := url.Parse(setting); err == nil && source.Scheme != "" {
+			return source.Redacted()
+		}
+	}
+	return redacted
+}
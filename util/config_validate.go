@@ -0,0 +1,160 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/scipiia/snippetbox/ratelimit"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// minSigningKeySize is the shortest accepted HMAC key, the size of the hash
+const minSigningKeySize = 32
+
+// ConfigError lists every problem of a config.
+type ConfigError struct {
+	Problems []string
+}
+
+func (err *ConfigError) Error() string {
+	return "invalid config: " + strings.Join(err.Problems, "; ")
+}
+
+// Validate checks all settings and returns a *ConfigError with every
+// problem found, so they can be fixed at once.
+func (config Config) Validate() error {
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if config.DBDriver == "" {
+		addProblem("DB_DRIVER is required")
+	}
+	if config.DBSource == "" {
+		addProblem("DB_SOURCE is required")
+	} else if _, err := url.Parse(config.DBSource); err != nil {
+		addProblem("DB_SOURCE is not a valid URL")
+	}
+
+	if len(config.TokenSymmetricKye) != chacha20poly1305.KeySize {
+		addProblem("TOKEN_SYMMETRIC_KEY must be exactly %d characters, got %d", chacha20poly1305.KeySize, len(config.TokenSymmetricKye))
+	}
+
+	// the download URLs of exports are signed with their own key, a leaked
+	// URL signature must not help to forge access tokens
+	if len(config.ExportURLSigningKey) < minSigningKeySize {
+		addProblem("EXPORT_URL_SIGNING_KEY must be at least %d characters, got %d", minSigningKeySize, len(config.ExportURLSigningKey))
+	} else if config.ExportURLSigningKey == config.TokenSymmetricKye {
+		addProblem("EXPORT_URL_SIGNING_KEY must differ from TOKEN_SYMMETRIC_KEY")
+	}
+
+	switch config.TaskBroker {
+	case "redis":
+		if config.RedisAddress == "" {
+			addProblem("REDIS_ADDRESS is required for the redis task broker")
+		}
+	case "memory":
+		// queued and retrying tasks are lost on restart while their outbox
+		// rows are already published
+		if config.Environment == "production" {
+			addProblem("TASK_BROKER memory loses queued tasks on restart and is not allowed in production")
+		}
+	default:
+		addProblem("TASK_BROKER must be redis or memory, got %q", config.TaskBroker)
+	}
+
+	addresses := []struct {
+		key     string
+		address string
+	}{
+		{"HTTP_SERVER_ADDRESS", config.HTTPServerAddress},
+		{"GRPC_SERVER_ADDRESS", config.GRPCServerAddress},
+		{"METRICS_SERVER_ADDRESS", config.MetricsServerAddress},
+	}
+	for _, address := range addresses {
+		if _, _, err := net.SplitHostPort(address.address); err != nil {
+			addProblem("%s must be host:port, got %q", address.key, address.address)
+		}
+	}
+
+	durations := []struct {
+		key      string
+		duration time.Duration
+	}{
+		{"ACCESS_TOKEN_DURATION", config.AccessTokenDuration},
+		{"REFRESH_TOKEN_DURATION", config.RefreshTokenDuration},
+		{"OUTBOX_RELAY_INTERVAL", config.OutboxRelayInterval},
+		{"OUTBOX_RETENTION", config.OutboxRetention},
+		{"EXPORT_URL_DURATION", config.ExportURLDuration},
+		{"EXPORT_RETENTION", config.ExportRetention},
+		{"EXPORT_PURGE_INTERVAL", config.ExportPurgeInterval},
+	}
+	for _, duration := range durations {
+		if duration.duration <= 0 {
+			addProblem("%s must be positive, got %s", duration.key, duration.duration)
+		}
+	}
+	if config.RefreshTokenDuration > 0 && config.RefreshTokenDuration < config.AccessTokenDuration {
+		addProblem("REFRESH_TOKEN_DURATION must not be shorter than ACCESS_TOKEN_DURATION")
+	}
+	if config.CORSMaxAge < 0 {
+		addProblem("CORS_MAX_AGE must not be negative")
+	}
+	if config.HSTSMaxAge < 0 {
+		addProblem("HSTS_MAX_AGE must not be negative")
+	}
+
+	switch config.TraceExporter {
+	case "", "none", "stdout", "otlp":
+	default:
+		addProblem("TRACE_EXPORTER must be none, stdout or otlp, got %q", config.TraceExporter)
+	}
+	if config.TraceSampleRatio < 0 || config.TraceSampleRatio > 1 {
+		addProblem("TRACE_SAMPLE_RATIO must be between 0 and 1, got %g", config.TraceSampleRatio)
+	}
+
+	if _, err := ratelimit.ParseLimit(config.RateLimitUser); err != nil {
+		addProblem("RATE_LIMIT_USER: %s", err)
+	}
+	if _, err := ratelimit.ParseLimit(config.RateLimitIP); err != nil {
+		addProblem("RATE_LIMIT_IP: %s", err)
+	}
+	if _, err := ratelimit.ParseMethodLimits(config.RateLimitMethods); err != nil {
+		addProblem("RATE_LIMIT_METHODS: %s", err)
+	}
+
+	if (config.TLSCertFile == "") != (config.TLSKeyFile == "") {
+		addProblem("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if config.TLSClientCAFile != "" && config.TLSCertFile == "" {
+		addProblem("TLS_CLIENT_CA_FILE needs TLS_CERT_FILE")
+	}
+	if config.TLSRequireClientCert && config.TLSClientCAFile == "" {
+		addProblem("TLS_REQUIRE_CLIENT_CERT needs TLS_CLIENT_CA_FILE")
+	}
+	switch config.TLSMinVersion {
+	case "", "1.2", "1.3":
+	default:
+		addProblem("TLS_MIN_VERSION must be 1.2 or 1.3, got %q", config.TLSMinVersion)
+	}
+	if config.GatewayGRPCClient && config.TLSRequireClientCert {
+		addProblem("GATEWAY_GRPC_CLIENT cannot be used with TLS_REQUIRE_CLIENT_CERT, the gateway has no client certificate")
+	}
+
+	if config.CORSAllowCredentials {
+		for _, origin := range config.CORSAllowedOrigins {
+			if origin == "*" {
+				addProblem("CORS_ALLOW_CREDENTIALS cannot be used with the * origin")
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ConfigError{Problems: problems}
+	}
+	return nil
+}
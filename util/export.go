@@ -0,0 +1,9 @@
+package util
+
+const (
+	ExportStatusPending   = "pending"
+	ExportStatusCompleted = "completed"
+	ExportStatusFailed    = "failed"
+	// the archive was purged after the retention period
+	ExportStatusExpired = "expired"
+)
@@ -0,0 +1,34 @@
+package util
+
+// Roles of organization members, each role includes the permissions of the
+// roles below it
+const (
+	OrgRoleOwner      = "owner"
+	OrgRoleMaintainer = "maintainer"
+	OrgRoleMember     = "member"
+	OrgRoleViewer     = "viewer"
+)
+
+var orgRoleRanks = map[string]int{
+	OrgRoleViewer:     1,
+	OrgRoleMember:     2,
+	OrgRoleMaintainer: 3,
+	OrgRoleOwner:      4,
+}
+
+// IsOrgRole returns true if the role is a role of organization members
+func IsOrgRole(role string) bool {
+	return orgRoleRanks[role] > 0
+}
+
+// HasOrgRole returns true if the role grants at least the permissions of the
+// required role, an empty role (no member) grants nothing
+func HasOrgRole(role string, required string) bool {
+	return IsOrgRole(role) && orgRoleRanks[role] >= orgRoleRanks[required]
+}
+
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+)
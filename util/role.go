@@ -0,0 +1,8 @@
+package util
+
+const (
+	UserRole  = "user"
+	AdminRole = "admin"
+	// internal services authenticated by a client certificate
+	ServiceRole = "service"
+)
@@ -0,0 +1,321 @@
+package util
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// snippet import archive formats
+const (
+	ImportFormatZip   = "zip"
+	ImportFormatTar   = "tar"
+	ImportFormatJSONL = "jsonl"
+)
+
+// what an import does with a snippet whose title already exists in the account
+const (
+	DuplicatePolicySkip      = "skip"
+	DuplicatePolicyOverwrite = "overwrite"
+)
+
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+const (
+	MaxArchiveFiles = 10000
+	// files above the size are reported instead of imported
+	MaxSnippetFileSize = 1 << 20
+	// limits what a small compressed archive may expand to
+	maxArchiveSize = 64 << 20
+)
+
+var ErrArchiveTooLarge = errors.New("archive is too large")
+
+// SnippetFile is one snippet read from an import archive
+type SnippetFile struct {
+	Name string
+	// Filename is the base name of the file, empty if the source has none
+	Filename string
+	Title    string
+	Content  string
+	Language string
+}
+
+// SnippetFileError reports why an archive entry was not imported
+type SnippetFileError struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// snippetLine is one line of a JSON Lines import
+type snippetLine struct {
+	Filename string `json:"filename"`
+	Title    string `json:"title"`
+	Content  string `json:"content"`
+	Language string `json:"language"`
+}
+
+var languages = map[string]string{
+	".c":     "c",
+	".h":     "c",
+	".cc":    "cpp",
+	".cpp":   "cpp",
+	".hpp":   "cpp",
+	".cs":    "csharp",
+	".css":   "css",
+	".go":    "go",
+	".html":  "html",
+	".java":  "java",
+	".js":    "javascript",
+	".json":  "json",
+	".kt":    "kotlin",
+	".md":    "markdown",
+	".php":   "php",
+	".py":    "python",
+	".rb":    "ruby",
+	".rs":    "rust",
+	".sh":    "shell",
+	".bash":  "shell",
+	".sql":   "sql",
+	".swift": "swift",
+	".ts":    "typescript",
+	".txt":   "text",
+	".yaml":  "yaml",
+	".yml":   "yaml",
+}
+
+// LanguageFromFilename returns the language of a file by its extension, or
+// an empty string when the extension is unknown.
+func LanguageFromFilename(name string) string {
+	return languages[strings.ToLower(path.Ext(name))]
+}
+
+// ParseSnippetArchive reads the snippets of an import archive. Entries which
+// can't be read are reported per file, an error is returned only when the
+// archive as a whole is unusable.
+func ParseSnippetArchive(format string, data []byte) ([]SnippetFile, []SnippetFileError, error) {
+	switch format {
+	case ImportFormatZip:
+		return parseZip(data)
+	case ImportFormatTar:
+		return parseTar(data)
+	case ImportFormatJSONL:
+		return parseJSONL(data)
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format: %s", format)
+	}
+}
+
+func parseZip(data []byte) ([]SnippetFile, []SnippetFileError, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read zip archive: %w", err)
+	}
+
+	var files []SnippetFile
+	var fileErrors []SnippetFileError
+	// the sizes in the zip headers can't be trusted, every byte inflated
+	// counts, also those of entries that are reported
+	limit := &archiveLimitReader{n: maxArchiveSize}
+
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() || skipArchiveEntry(f.Name) {
+			continue
+		}
+
+		if len(files)+len(fileErrors) >= MaxArchiveFiles {
+			return nil, nil, fmt.Errorf("archive contains more than %d files", MaxArchiveFiles)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			fileErrors = append(fileErrors, SnippetFileError{Name: f.Name, Error: err.Error()})
+			continue
+		}
+
+		limit.r = rc
+		content, err := readSnippetFile(limit)
+		rc.Close()
+		if errors.Is(err, ErrArchiveTooLarge) {
+			return nil, nil, ErrArchiveTooLarge
+		}
+		if err != nil {
+			fileErrors = append(fileErrors, SnippetFileError{Name: f.Name, Error: err.Error()})
+			continue
+		}
+
+		files = append(files, newSnippetFile(f.Name, content))
+	}
+
+	return files, fileErrors, nil
+}
+
+// parseTar reads plain and gzip compressed tar archives.
+func parseTar(data []byte) ([]SnippetFile, []SnippetFileError, error) {
+	var r io.Reader = bytes.NewReader(data)
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read gzip archive: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	reader := tar.NewReader(&archiveLimitReader{r: r, n: maxArchiveSize})
+
+	var files []SnippetFile
+	var fileErrors []SnippetFileError
+
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, ErrArchiveTooLarge) {
+			return nil, nil, ErrArchiveTooLarge
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read tar archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg || skipArchiveEntry(header.Name) {
+			continue
+		}
+
+		if len(files)+len(fileErrors) >= MaxArchiveFiles {
+			return nil, nil, fmt.Errorf("archive contains more than %d files", MaxArchiveFiles)
+		}
+
+		content, err := readSnippetFile(reader)
+		if errors.Is(err, ErrArchiveTooLarge) {
+			return nil, nil, ErrArchiveTooLarge
+		}
+		if err != nil {
+			fileErrors = append(fileErrors, SnippetFileError{Name: header.Name, Error: err.Error()})
+			continue
+		}
+
+		files = append(files, newSnippetFile(header.Name, content))
+	}
+
+	return files, fileErrors, nil
+}
+
+// parseJSONL reads one snippet object per line, the title and language fall
+// back to the filename when they are missing.
+func parseJSONL(data []byte) ([]SnippetFile, []SnippetFileError, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 2*MaxSnippetFileSize)
+
+	var files []SnippetFile
+	var fileErrors []SnippetFileError
+
+	for n := 1; scanner.Scan(); n++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		if len(files)+len(fileErrors) >= MaxArchiveFiles {
+			return nil, nil, fmt.Errorf("file contains more than %d snippets", MaxArchiveFiles)
+		}
+
+		name := fmt.Sprintf("line %d", n)
+
+		var item snippetLine
+		if err := json.Unmarshal(line, &item); err != nil {
+			fileErrors = append(fileErrors, SnippetFileError{Name: name, Error: "invalid json"})
+			continue
+		}
+
+		file := newSnippetFile(item.Filename, item.Content)
+		file.Name = name
+		if item.Title != "" {
+			file.Title = item.Title
+		}
+		if item.Language != "" {
+			file.Language = item.Language
+		}
+
+		files = append(files, file)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read json lines: %w", err)
+	}
+
+	return files, fileErrors, nil
+}
+
+// newSnippetFile titles the snippet with the file name without directories
+func newSnippetFile(name string, content string) SnippetFile {
+	file := SnippetFile{
+		Name:     name,
+		Content:  content,
+		Language: LanguageFromFilename(name),
+	}
+	if name != "" {
+		file.Filename = path.Base(name)
+		file.Title = file.Filename
+	}
+	return file
+}
+
+func readSnippetFile(r io.Reader) (string, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxSnippetFileSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if len(content) > MaxSnippetFileSize {
+		return "", fmt.Errorf("file is larger than %d bytes", MaxSnippetFileSize)
+	}
+
+	return string(content), nil
+}
+
+// archiveLimitReader fails with ErrArchiveTooLarge once more than n bytes
+// are read, so an oversized archive is rejected instead of cut short
+type archiveLimitReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *archiveLimitReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, ErrArchiveTooLarge
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return 0, ErrArchiveTooLarge
+	}
+	return n, err
+}
+
+// skipArchiveEntry ignores hidden files and metadata added by archivers
+func skipArchiveEntry(name string) bool {
+	for _, part := range strings.Split(path.Clean(name), "/") {
+		if (strings.HasPrefix(part, ".") && part != "." && part != "..") || part == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
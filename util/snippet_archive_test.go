@@ -0,0 +1,139 @@
+package util
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguageFromFilename(t *testing.T) {
+	require.Equal(t, "go", LanguageFromFilename("main.go"))
+	require.Equal(t, "python", LanguageFromFilename("dir/Script.PY"))
+	require.Empty(t, LanguageFromFilename("Makefile"))
+}
+
+func TestParseSnippetArchiveZip(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"gists/hello.go":      "package main",
+		"notes.txt":           "some notes",
+		".hidden":             "secret",
+		"__MACOSX/._hello.go": "metadata",
+		"large.md":            strings.Repeat("a", MaxSnippetFileSize+1),
+	} {
+		f, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	files, fileErrors, err := ParseSnippetArchive(ImportFormatZip, buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Len(t, fileErrors, 1)
+	require.Equal(t, "large.md", fileErrors[0].Name)
+
+	byTitle := map[string]SnippetFile{}
+	for _, file := range files {
+		byTitle[file.Title] = file
+	}
+	require.Equal(t, "package main", byTitle["hello.go"].Content)
+	require.Equal(t, "go", byTitle["hello.go"].Language)
+	require.Equal(t, "text", byTitle["notes.txt"].Language)
+}
+
+func TestParseSnippetArchiveZipTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	// every entry is reported as too large, their bytes still add up
+	content := make([]byte, MaxSnippetFileSize+1)
+	for i := 0; i <= maxArchiveSize/MaxSnippetFileSize; i++ {
+		f, err := writer.Create(fmt.Sprintf("large%d.txt", i))
+		require.NoError(t, err)
+		_, err = f.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	_, _, err := ParseSnippetArchive(ImportFormatZip, buf.Bytes())
+	require.ErrorIs(t, err, ErrArchiveTooLarge)
+}
+
+func TestParseSnippetArchiveTarGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	writer := tar.NewWriter(gz)
+
+	content := "SELECT 1;"
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "./queries", Typeflag: tar.TypeDir, Mode: 0755}))
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "./queries/one.sql", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+	_, err := writer.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, gz.Close())
+
+	files, fileErrors, err := ParseSnippetArchive(ImportFormatTar, buf.Bytes())
+	require.NoError(t, err)
+	require.Empty(t, fileErrors)
+	require.Len(t, files, 1)
+	require.Equal(t, "one.sql", files[0].Title)
+	require.Equal(t, "sql", files[0].Language)
+	require.Equal(t, content, files[0].Content)
+}
+
+func TestParseSnippetArchiveTarTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	writer := tar.NewWriter(gz)
+
+	// compresses to little but expands past the archive limit
+	size := int64(maxArchiveSize + 1<<20)
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "large.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: size}))
+	chunk := make([]byte, 1<<20)
+	for written := int64(0); written < size; written += int64(len(chunk)) {
+		_, err := writer.Write(chunk)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, gz.Close())
+
+	_, _, err := ParseSnippetArchive(ImportFormatTar, buf.Bytes())
+	require.ErrorIs(t, err, ErrArchiveTooLarge)
+}
+
+func TestParseSnippetArchiveJSONL(t *testing.T) {
+	data := `{"filename": "hello.rb", "content": "puts 1"}
+
+{"title": "Custom", "content": "x", "language": "go"}
+not json
+`
+
+	files, fileErrors, err := ParseSnippetArchive(ImportFormatJSONL, []byte(data))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Equal(t, "hello.rb", files[0].Title)
+	require.Equal(t, "ruby", files[0].Language)
+	require.Equal(t, "line 1", files[0].Name)
+	require.Equal(t, "Custom", files[1].Title)
+	require.Equal(t, "go", files[1].Language)
+
+	require.Len(t, fileErrors, 1)
+	require.Equal(t, "line 4", fileErrors[0].Name)
+}
+
+func TestParseSnippetArchiveInvalid(t *testing.T) {
+	_, _, err := ParseSnippetArchive(ImportFormatZip, []byte("not a zip"))
+	require.Error(t, err)
+
+	_, _, err = ParseSnippetArchive("rar", []byte("data"))
+	require.Error(t, err)
+}
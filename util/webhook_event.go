@@ -0,0 +1,17 @@
+package util
+
+const (
+	EventSnippetCreated = "snippet.created"
+	EventSnippetUpdated = "snippet.updated"
+	EventSnippetDeleted = "snippet.deleted"
+	EventUserUpdated    = "user.updated"
+)
+
+// IsSupportedWebhookEvent returns true if webhooks can subscribe to the event
+func IsSupportedWebhookEvent(event string) bool {
+	switch event {
+	case EventSnippetCreated, EventSnippetUpdated, EventSnippetDeleted, EventUserUpdated:
+		return true
+	}
+	return false
+}
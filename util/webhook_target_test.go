@@ -0,0 +1,60 @@
+package util
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"100.63.255.255", true},
+		{"100.128.0.1", true},
+		{"198.20.0.1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"0.1.2.3", false},
+		{"::", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+		{"100.64.0.1", false},
+		{"100.100.100.200", false},
+		{"::ffff:100.100.100.200", false},
+		{"192.0.0.170", false},
+		{"198.18.0.1", false},
+		{"198.19.255.255", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"64:ff9b::a00:1", false},
+		{"64:ff9b::7f00:1", false},
+		{"64:ff9b:1::1", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.public, IsPublicIP(net.ParseIP(tc.ip)), tc.ip)
+	}
+}
+
+func TestCheckWebhookHost(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, CheckWebhookHost(ctx, "93.184.216.34"))
+	require.ErrorIs(t, CheckWebhookHost(ctx, "169.254.169.254"), ErrNonPublicAddress)
+	require.ErrorIs(t, CheckWebhookHost(ctx, "100.100.100.200"), ErrNonPublicAddress)
+	require.ErrorIs(t, CheckWebhookHost(ctx, "64:ff9b::a9fe:a9fe"), ErrNonPublicAddress)
+	require.ErrorIs(t, CheckWebhookHost(ctx, "localhost"), ErrNonPublicAddress)
+}
@@ -1,11 +1,23 @@
 package validation
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/mail"
+	"net/url"
 	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/scipiia/snippetbox/util"
 )
 
+const maxImportArchiveSize = 4<<20 - 1024
+
+const maxSnippetFiles = 20
+
 var (
 	isValidName     = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
@@ -52,3 +64,159 @@ func ValidateFullName(value string) error {
 	}
 	return nil
 }
+
+func ValidateQueueName(value string) error {
+	if err := ValidateString(value, 1, 100); err != nil {
+		return err
+	}
+	if !isValidName(value) {
+		return fmt.Errorf("must contain only lowercase letters, digits, or underscore")
+	}
+	return nil
+}
+
+func ValidateTaskID(value string) error {
+	return ValidateString(value, 1, 200)
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 1 || value > 100 {
+		return fmt.Errorf("must be from %d-%d", 1, 100)
+	}
+	return nil
+}
+
+func ValidateWebhookURL(value string) error {
+	if err := ValidateString(value, 8, 2000); err != nil {
+		return err
+	}
+	u, err := url.ParseRequestURI(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("is not valid http or https url")
+	}
+	if err := util.CheckWebhookHost(context.Background(), u.Hostname()); err != nil {
+		if errors.Is(err, util.ErrNonPublicAddress) {
+			return fmt.Errorf("must not point to a loopback, private or link-local address")
+		}
+		return fmt.Errorf("host cannot be resolved")
+	}
+	return nil
+}
+
+func ValidateWebhookEvents(values []string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("must contain at least one event")
+	}
+	for _, value := range values {
+		if !util.IsSupportedWebhookEvent(value) {
+			return fmt.Errorf("unsupported event %q", value)
+		}
+	}
+	return nil
+}
+
+func ValidateWebhookSecret(value string) error {
+	return ValidateString(value, 16, 200)
+}
+
+func ValidateID(value int64) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateSnippetTitle(value string) error {
+	return ValidateString(value, 1, 200)
+}
+
+func ValidateSnippetContent(value string) error {
+	if err := ValidateString(value, 1, util.MaxSnippetFileSize); err != nil {
+		return err
+	}
+	if !utf8.ValidString(value) {
+		return fmt.Errorf("must be valid UTF-8 text")
+	}
+	return nil
+}
+
+func ValidateSnippetLanguage(value string) error {
+	return ValidateString(value, 0, 50)
+}
+
+// filenames are used in the raw file URLs, so they cannot contain slashes
+func ValidateSnippetFilename(value string) error {
+	if err := ValidateString(value, 1, 255); err != nil {
+		return err
+	}
+	if strings.ContainsAny(value, `/\`) || value == "." || value == ".." {
+		return fmt.Errorf("must be a file name without directories")
+	}
+	if !utf8.ValidString(value) {
+		return fmt.Errorf("must be valid UTF-8 text")
+	}
+	return nil
+}
+
+func ValidateSnippetFileCount(value int) error {
+	if value < 1 || value > maxSnippetFiles {
+		return fmt.Errorf("must contain from %d-%d files", 1, maxSnippetFiles)
+	}
+	return nil
+}
+
+// the gRPC server receives messages of up to 4MB
+func ValidateImportArchive(value []byte) error {
+	if len(value) == 0 || len(value) > maxImportArchiveSize {
+		return fmt.Errorf("must contain from %d-%d bytes", 1, maxImportArchiveSize)
+	}
+	return nil
+}
+
+func ValidateSessionID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid uuid")
+	}
+	return nil
+}
+
+func ValidateAuditFilter(value string) error {
+	return ValidateString(value, 1, 200)
+}
+
+func ValidateOrgRole(value string) error {
+	if !util.IsOrgRole(value) {
+		return fmt.Errorf("must be one of %s, %s, %s or %s", util.OrgRoleOwner, util.OrgRoleMaintainer, util.OrgRoleMember, util.OrgRoleViewer)
+	}
+	return nil
+}
+
+func ValidateDisplayName(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+func ValidateCollectionName(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+func ValidateCommentBody(value string) error {
+	return ValidateString(value, 1, 5000)
+}
+
+// ValidateLineRange checks a 1-based range of lines, the end is inclusive
+func ValidateLineRange(start, end int32) error {
+	if start < 1 {
+		return fmt.Errorf("must start at line 1 or later")
+	}
+	if end < start {
+		return fmt.Errorf("must not end before it starts")
+	}
+	return nil
+}
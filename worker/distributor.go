@@ -2,14 +2,28 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
 )
 
+// task brokers selected by the TASK_BROKER config. The memory broker keeps
+// tasks in the process: queued and retrying tasks are lost on restart after
+// the outbox has marked them published, so it is meant for development.
+const (
+	BrokerRedis  = "redis"
+	BrokerMemory = "memory"
+)
+
+// defaultMaxRetry matches the asynq default when no MaxRetry option is given
+const defaultMaxRetry = 25
+
 type TaskDistributor interface {
-	DistributeTaskSendVerifyEmail(
+	DistributeTask(
 		ctx context.Context,
-		payload *PayloadSendVerifyEmail,
+		taskType string,
+		payload []byte,
 		opt ...asynq.Option,
 	) error
 }
@@ -24,3 +38,30 @@ func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
 		client: client,
 	}
 }
+
+// DistributeTask enqueues an already encoded payload, it is used by the typed
+// DistributeTask* helpers and by the outbox relay.
+func (distributor *RedisTaskDistributor) DistributeTask(
+	ctx context.Context,
+	taskType string,
+	payload []byte,
+	opt ...asynq.Option,
+) error {
+	ctx, payload = propagateRequestID(ctx, payload)
+	ctx, span, payload := startEnqueueSpan(ctx, taskType, payload)
+	defer span.End()
+
+	task := asynq.NewTask(taskType, payload, opt...)
+	taskInfo, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return spanError(span, fmt.Errorf("failed to enqueue task: %w", err))
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", taskInfo.Queue).
+		Int("max_retry", taskInfo.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
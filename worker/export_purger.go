@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/blob"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+const exportPurgeBatchSize = 100
+
+// ExportPurger deletes export archives once they expire.
+type ExportPurger struct {
+	store     db.Store
+	blobStore blob.Store
+	interval  time.Duration
+}
+
+func NewExportPurger(store db.Store, blobStore blob.Store, interval time.Duration) *ExportPurger {
+	return &ExportPurger{
+		store:     store,
+		blobStore: blobStore,
+		interval:  interval,
+	}
+}
+
+// Start purges until ctx is cancelled.
+func (purger *ExportPurger) Start(ctx context.Context) error {
+	ticker := time.NewTicker(purger.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := purger.Purge(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to purge exports")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes the archives of expired exports batch by batch, the export
+// rows are kept with the expired status.
+func (purger *ExportPurger) Purge(ctx context.Context) error {
+	for {
+		exports, err := purger.store.ListExpiredExports(ctx, db.ListExpiredExportsParams{
+			Before:     time.Now(),
+			LimitCount: exportPurgeBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, export := range exports {
+			if export.BlobKey.Valid {
+				if err := purger.blobStore.Delete(ctx, export.BlobKey.String); err != nil {
+					return err
+				}
+			}
+
+			if err := purger.store.ExpireExport(ctx, export.ID); err != nil {
+				return err
+			}
+		}
+
+		if len(exports) > 0 {
+			log.Info().Int("purged", len(exports)).Msg("purged expired exports")
+		}
+
+		if len(exports) < exportPurgeBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+type TaskInspector interface {
+	ListQueues() ([]*asynq.QueueInfo, error)
+	ListTasks(queue string, state asynq.TaskState, pageID, pageSize int) ([]*asynq.TaskInfo, error)
+	RunTask(queue, id string) error
+	DeleteTask(queue, id string) error
+	PauseQueue(queue string) error
+	ResumeQueue(queue string) error
+	// ListWorkers lists the task processors with a recent heartbeat
+	ListWorkers() ([]*asynq.ServerInfo, error)
+}
+
+type RedisTaskInspector struct {
+	inspector *asynq.Inspector
+}
+
+func NewRedisTaskInspector(redisOpt asynq.RedisClientOpt) TaskInspector {
+	inspector := asynq.NewInspector(redisOpt)
+	return &RedisTaskInspector{
+		inspector: inspector,
+	}
+}
+
+func (inspector *RedisTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	queues, err := inspector.inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	infos := make([]*asynq.QueueInfo, 0, len(queues))
+	for _, queue := range queues {
+		info, err := inspector.inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue info: %w", err)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// ListTasks lists one page of tasks in the given state. Tasks which ran out
+// of retries are in the archived state (asynq's former "dead" state).
+func (inspector *RedisTaskInspector) ListTasks(queue string, state asynq.TaskState, pageID, pageSize int) ([]*asynq.TaskInfo, error) {
+	opts := []asynq.ListOption{
+		asynq.Page(pageID),
+		asynq.PageSize(pageSize),
+	}
+
+	switch state {
+	case asynq.TaskStatePending:
+		return inspector.inspector.ListPendingTasks(queue, opts...)
+	case asynq.TaskStateScheduled:
+		return inspector.inspector.ListScheduledTasks(queue, opts...)
+	case asynq.TaskStateRetry:
+		return inspector.inspector.ListRetryTasks(queue, opts...)
+	case asynq.TaskStateArchived:
+		return inspector.inspector.ListArchivedTasks(queue, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported task state: %s", state)
+	}
+}
+
+// RunTask moves a scheduled, retry or archived task to pending.
+func (inspector *RedisTaskInspector) RunTask(queue, id string) error {
+	return inspector.inspector.RunTask(queue, id)
+}
+
+func (inspector *RedisTaskInspector) DeleteTask(queue, id string) error {
+	return inspector.inspector.DeleteTask(queue, id)
+}
+
+func (inspector *RedisTaskInspector) PauseQueue(queue string) error {
+	return inspector.inspector.PauseQueue(queue)
+}
+
+func (inspector *RedisTaskInspector) ResumeQueue(queue string) error {
+	return inspector.inspector.UnpauseQueue(queue)
+}
+
+// ListWorkers lists the asynq servers, a server which stops sending
+// heartbeats expires from Redis.
+func (inspector *RedisTaskInspector) ListWorkers() ([]*asynq.ServerInfo, error) {
+	return inspector.inspector.Servers()
+}
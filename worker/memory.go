@@ -0,0 +1,533 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// like asynq, a queue keeps at most this many archived tasks for this long
+const (
+	memoryMaxArchiveSize = 10000
+	memoryArchiveTTL     = 90 * 24 * time.Hour
+)
+
+// MemoryBroker keeps tasks in process memory, it replaces Redis when the
+// API and the worker run in one process. Tasks which are not processed yet
+// are lost on restart, the outbox rows they came from are already published.
+type MemoryBroker struct {
+	mu             sync.Mutex
+	seq            int64
+	tasks          map[string]*memoryTask
+	queues         map[string]*memoryQueue
+	servers        map[string]*memoryServer
+	available      chan struct{}
+	maxArchiveSize int
+	archiveTTL     time.Duration
+}
+
+type memoryTask struct {
+	info *asynq.TaskInfo
+	seq  int64
+}
+
+// memoryServer is a processor of the broker with its last heartbeat
+type memoryServer struct {
+	info      *asynq.ServerInfo
+	heartbeat time.Time
+}
+
+type memoryQueue struct {
+	paused    bool
+	processed int
+	failed    int
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		tasks:          make(map[string]*memoryTask),
+		queues:         make(map[string]*memoryQueue),
+		servers:        make(map[string]*memoryServer),
+		available:      make(chan struct{}, 1),
+		maxArchiveSize: memoryMaxArchiveSize,
+		archiveTTL:     memoryArchiveTTL,
+	}
+}
+
+func (broker *MemoryBroker) enqueue(info *asynq.TaskInfo) error {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	if _, ok := broker.tasks[info.ID]; ok {
+		return asynq.ErrTaskIDConflict
+	}
+
+	broker.seq++
+	broker.tasks[info.ID] = &memoryTask{
+		info: info,
+		seq:  broker.seq,
+	}
+	broker.queue(info.Queue)
+	broker.signal()
+
+	return nil
+}
+
+// queue returns the queue, registering it on first use like asynq does.
+func (broker *MemoryBroker) queue(name string) *memoryQueue {
+	queue, ok := broker.queues[name]
+	if !ok {
+		queue = &memoryQueue{}
+		broker.queues[name] = queue
+	}
+	return queue
+}
+
+// signal wakes up one waiting worker without blocking.
+func (broker *MemoryBroker) signal() {
+	select {
+	case broker.available <- struct{}{}:
+	default:
+	}
+}
+
+// dequeue marks the oldest ready task of a queue picked by weight as active.
+// It returns nil when no task is ready.
+func (broker *MemoryBroker) dequeue(priority map[string]int) *asynq.TaskInfo {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	now := time.Now()
+	ready := make(map[string]*memoryTask)
+	for _, task := range broker.tasks {
+		if broker.queue(task.info.Queue).paused {
+			continue
+		}
+
+		switch task.info.State {
+		case asynq.TaskStateScheduled, asynq.TaskStateRetry:
+			if task.info.NextProcessAt.After(now) {
+				continue
+			}
+			task.info.State = asynq.TaskStatePending
+		case asynq.TaskStatePending:
+		default:
+			continue
+		}
+
+		oldest, ok := ready[task.info.Queue]
+		if !ok || task.seq < oldest.seq {
+			ready[task.info.Queue] = task
+		}
+	}
+
+	if len(ready) == 0 {
+		return nil
+	}
+
+	task := ready[pickQueue(ready, priority)]
+	task.info.State = asynq.TaskStateActive
+	task.info.NextProcessAt = time.Time{}
+
+	info := *task.info
+	return &info
+}
+
+// pickQueue picks one of the ready queues at random, weighted by priority.
+// Queues which are not configured get the lowest weight.
+func pickQueue(ready map[string]*memoryTask, priority map[string]int) string {
+	names := make([]string, 0, len(ready))
+	total := 0
+	for name := range ready {
+		names = append(names, name)
+		total += queueWeight(name, priority)
+	}
+	sort.Strings(names)
+
+	n := rand.Intn(total)
+	for _, name := range names {
+		n -= queueWeight(name, priority)
+		if n < 0 {
+			return name
+		}
+	}
+	return names[len(names)-1]
+}
+
+func queueWeight(name string, priority map[string]int) int {
+	if weight, ok := priority[name]; ok && weight > 0 {
+		return weight
+	}
+	return 1
+}
+
+// done records the result of an active task. Failed tasks are retried after
+// delay until they run out of retries or return asynq.SkipRetry, then they
+// are archived.
+func (broker *MemoryBroker) done(id string, err error, delay time.Duration) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	task, ok := broker.tasks[id]
+	if !ok {
+		// deleted while it was running
+		return
+	}
+
+	queue := broker.queue(task.info.Queue)
+	queue.processed++
+
+	if err == nil {
+		delete(broker.tasks, id)
+		return
+	}
+
+	queue.failed++
+	task.info.LastErr = err.Error()
+	task.info.LastFailedAt = time.Now()
+
+	if errors.Is(err, asynq.SkipRetry) || task.info.Retried >= task.info.MaxRetry {
+		task.info.State = asynq.TaskStateArchived
+		broker.pruneArchive(task.info.Queue)
+		return
+	}
+
+	task.info.Retried++
+	task.info.State = asynq.TaskStateRetry
+	task.info.NextProcessAt = time.Now().Add(delay)
+}
+
+// pruneArchive drops the archived tasks of a queue which have expired and
+// the oldest ones beyond the size limit, asynq trims its archive the same way
+// whenever a task is archived. It must be called with the lock held.
+func (broker *MemoryBroker) pruneArchive(queue string) {
+	expired := time.Now().Add(-broker.archiveTTL)
+
+	var archived []*memoryTask
+	for id, task := range broker.tasks {
+		if task.info.Queue != queue || task.info.State != asynq.TaskStateArchived {
+			continue
+		}
+		if task.info.LastFailedAt.Before(expired) {
+			delete(broker.tasks, id)
+			continue
+		}
+		archived = append(archived, task)
+	}
+
+	if len(archived) <= broker.maxArchiveSize {
+		return
+	}
+
+	sort.Slice(archived, func(i, j int) bool {
+		return archived[i].info.LastFailedAt.Before(archived[j].info.LastFailedAt)
+	})
+	for _, task := range archived[:len(archived)-broker.maxArchiveSize] {
+		delete(broker.tasks, task.info.ID)
+	}
+}
+
+// heartbeat records that the processor is alive.
+func (broker *MemoryBroker) heartbeat(info *asynq.ServerInfo) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	broker.servers[info.ID] = &memoryServer{
+		info:      info,
+		heartbeat: time.Now(),
+	}
+}
+
+func (broker *MemoryBroker) removeServer(id string) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	delete(broker.servers, id)
+}
+
+// MemoryTaskDistributor enqueues tasks to a MemoryBroker.
+type MemoryTaskDistributor struct {
+	broker *MemoryBroker
+}
+
+func NewMemoryTaskDistributor(broker *MemoryBroker) TaskDistributor {
+	return &MemoryTaskDistributor{
+		broker: broker,
+	}
+}
+
+func (distributor *MemoryTaskDistributor) DistributeTask(
+	ctx context.Context,
+	taskType string,
+	payload []byte,
+	opt ...asynq.Option,
+) error {
+	ctx, payload = propagateRequestID(ctx, payload)
+	ctx, span, payload := startEnqueueSpan(ctx, taskType, payload)
+	defer span.End()
+
+	info := &asynq.TaskInfo{
+		ID:       uuid.NewString(),
+		Queue:    QueueDefault,
+		Type:     taskType,
+		Payload:  payload,
+		State:    asynq.TaskStatePending,
+		MaxRetry: defaultMaxRetry,
+	}
+
+	for _, o := range opt {
+		switch o.Type() {
+		case asynq.TaskIDOpt:
+			info.ID = o.Value().(string)
+		case asynq.QueueOpt:
+			info.Queue = o.Value().(string)
+		case asynq.MaxRetryOpt:
+			info.MaxRetry = o.Value().(int)
+		case asynq.ProcessInOpt:
+			info.NextProcessAt = time.Now().Add(o.Value().(time.Duration))
+		case asynq.ProcessAtOpt:
+			info.NextProcessAt = o.Value().(time.Time)
+		default:
+			return spanError(span, fmt.Errorf("unsupported memory task option: %s", o.String()))
+		}
+	}
+
+	if info.NextProcessAt.After(time.Now()) {
+		info.State = asynq.TaskStateScheduled
+	} else {
+		info.NextProcessAt = time.Now()
+	}
+
+	err := distributor.broker.enqueue(info)
+	if err != nil {
+		return spanError(span, fmt.Errorf("failed to enqueue task: %w", err))
+	}
+
+	log.Ctx(ctx).Info().Str("type", info.Type).
+		Bytes("payload", info.Payload).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// MemoryTaskInspector inspects the tasks of a MemoryBroker.
+type MemoryTaskInspector struct {
+	broker *MemoryBroker
+}
+
+func NewMemoryTaskInspector(broker *MemoryBroker) TaskInspector {
+	return &MemoryTaskInspector{
+		broker: broker,
+	}
+}
+
+func (inspector *MemoryTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	infos := make(map[string]*asynq.QueueInfo, len(broker.queues))
+	for name, queue := range broker.queues {
+		infos[name] = &asynq.QueueInfo{
+			Queue:          name,
+			Paused:         queue.paused,
+			Processed:      queue.processed,
+			Failed:         queue.failed,
+			ProcessedTotal: queue.processed,
+			FailedTotal:    queue.failed,
+			Timestamp:      time.Now(),
+		}
+	}
+
+	for _, task := range broker.tasks {
+		info := infos[task.info.Queue]
+		info.Size++
+		switch task.info.State {
+		case asynq.TaskStateActive:
+			info.Active++
+		case asynq.TaskStatePending:
+			info.Pending++
+		case asynq.TaskStateScheduled:
+			info.Scheduled++
+		case asynq.TaskStateRetry:
+			info.Retry++
+		case asynq.TaskStateArchived:
+			info.Archived++
+		}
+	}
+
+	rsp := make([]*asynq.QueueInfo, 0, len(infos))
+	for _, info := range infos {
+		rsp = append(rsp, info)
+	}
+	sort.Slice(rsp, func(i, j int) bool {
+		return rsp[i].Queue < rsp[j].Queue
+	})
+
+	return rsp, nil
+}
+
+// ListTasks lists one page of tasks in the given state, pending tasks in
+// enqueue order and the others by the time they are due, like asynq.
+func (inspector *MemoryTaskInspector) ListTasks(queue string, state asynq.TaskState, pageID, pageSize int) ([]*asynq.TaskInfo, error) {
+	switch state {
+	case asynq.TaskStatePending, asynq.TaskStateScheduled, asynq.TaskStateRetry, asynq.TaskStateArchived:
+	default:
+		return nil, fmt.Errorf("unsupported task state: %s", state)
+	}
+
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	if _, ok := broker.queues[queue]; !ok {
+		return nil, fmt.Errorf("%w: %s", asynq.ErrQueueNotFound, queue)
+	}
+
+	var tasks []*memoryTask
+	for _, task := range broker.tasks {
+		if task.info.Queue == queue && task.info.State == state {
+			tasks = append(tasks, task)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		switch state {
+		case asynq.TaskStateScheduled, asynq.TaskStateRetry:
+			return tasks[i].info.NextProcessAt.Before(tasks[j].info.NextProcessAt)
+		case asynq.TaskStateArchived:
+			return tasks[i].info.LastFailedAt.Before(tasks[j].info.LastFailedAt)
+		default:
+			return tasks[i].seq < tasks[j].seq
+		}
+	})
+
+	start := (pageID - 1) * pageSize
+	if start < 0 || start >= len(tasks) {
+		return []*asynq.TaskInfo{}, nil
+	}
+	end := start + pageSize
+	if end > len(tasks) {
+		end = len(tasks)
+	}
+
+	infos := make([]*asynq.TaskInfo, 0, end-start)
+	for _, task := range tasks[start:end] {
+		info := *task.info
+		infos = append(infos, &info)
+	}
+
+	return infos, nil
+}
+
+// RunTask moves a scheduled, retry or archived task to pending.
+func (inspector *MemoryTaskInspector) RunTask(queue, id string) error {
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	task, err := broker.find(queue, id)
+	if err != nil {
+		return err
+	}
+
+	switch task.info.State {
+	case asynq.TaskStateActive:
+		return fmt.Errorf("task is already running")
+	case asynq.TaskStatePending:
+		return fmt.Errorf("task is already pending for run")
+	}
+
+	task.info.State = asynq.TaskStatePending
+	task.info.NextProcessAt = time.Now()
+	broker.signal()
+
+	return nil
+}
+
+func (inspector *MemoryTaskInspector) DeleteTask(queue, id string) error {
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	task, err := broker.find(queue, id)
+	if err != nil {
+		return err
+	}
+
+	if task.info.State == asynq.TaskStateActive {
+		return fmt.Errorf("cannot delete task in active state")
+	}
+
+	delete(broker.tasks, id)
+	return nil
+}
+
+func (inspector *MemoryTaskInspector) PauseQueue(queue string) error {
+	return inspector.setPaused(queue, true)
+}
+
+func (inspector *MemoryTaskInspector) ResumeQueue(queue string) error {
+	return inspector.setPaused(queue, false)
+}
+
+func (inspector *MemoryTaskInspector) setPaused(name string, paused bool) error {
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	queue := broker.queue(name)
+	if queue.paused == paused {
+		if paused {
+			return fmt.Errorf("queue %q is already paused", name)
+		}
+		return fmt.Errorf("queue %q is not paused", name)
+	}
+
+	queue.paused = paused
+	broker.signal()
+
+	return nil
+}
+
+// ListWorkers lists the processors whose heartbeat has not expired.
+func (inspector *MemoryTaskInspector) ListWorkers() ([]*asynq.ServerInfo, error) {
+	broker := inspector.broker
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	infos := make([]*asynq.ServerInfo, 0, len(broker.servers))
+	for _, server := range broker.servers {
+		if time.Since(server.heartbeat) > memoryHeartbeatTTL {
+			continue
+		}
+		info := *server.info
+		infos = append(infos, &info)
+	}
+
+	return infos, nil
+}
+
+// find must be called with the lock held.
+func (broker *MemoryBroker) find(queue, id string) (*memoryTask, error) {
+	if _, ok := broker.queues[queue]; !ok {
+		return nil, fmt.Errorf("%w: %s", asynq.ErrQueueNotFound, queue)
+	}
+
+	task, ok := broker.tasks[id]
+	if !ok || task.info.Queue != queue {
+		return nil, fmt.Errorf("%w: %s", asynq.ErrTaskNotFound, id)
+	}
+
+	return task, nil
+}
@@ -0,0 +1,221 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/scipiia/snippetbox/blob"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+const (
+	memoryConcurrency     = 4
+	memoryPollInterval    = time.Second
+	memoryShutdownTimeout = 8 * time.Second
+	// like asynq, a processor is gone after missing a few heartbeats
+	memoryHeartbeatInterval = 5 * time.Second
+	memoryHeartbeatTTL      = 3 * memoryHeartbeatInterval
+)
+
+type memoryContextKey int
+
+const (
+	taskIDKey memoryContextKey = iota
+	retryCountKey
+	maxRetryKey
+	queueNameKey
+)
+
+// MemoryTaskProcessor runs the task handlers on tasks of a MemoryBroker with
+// the same retry, delay and queue priority rules as the Redis processor.
+type MemoryTaskProcessor struct {
+	taskHandler
+	broker *MemoryBroker
+	info   *asynq.ServerInfo
+	stop   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewMemoryTaskProcessor(
+	broker *MemoryBroker,
+	store db.Store,
+	distributor TaskDistributor,
+	blobStore blob.Store,
+	exportRetention time.Duration,
+) TaskProcessor {
+	return &MemoryTaskProcessor{
+		taskHandler: taskHandler{
+			store:           store,
+			distributor:     distributor,
+			blobStore:       blobStore,
+			exportRetention: exportRetention,
+		},
+		broker: broker,
+		stop:   make(chan struct{}),
+	}
+}
+
+func (processor *MemoryTaskProcessor) Start() error {
+	mux := newServeMux(&processor.taskHandler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	processor.cancel = cancel
+
+	host, _ := os.Hostname()
+	processor.info = &asynq.ServerInfo{
+		ID:          uuid.NewString(),
+		Host:        host,
+		PID:         os.Getpid(),
+		Concurrency: memoryConcurrency,
+		Queues:      queuePriority,
+		Started:     time.Now(),
+		Status:      "active",
+	}
+	processor.broker.heartbeat(processor.info)
+
+	processor.wg.Add(1)
+	go func() {
+		defer processor.wg.Done()
+		processor.sendHeartbeats()
+	}()
+
+	for i := 0; i < memoryConcurrency; i++ {
+		processor.wg.Add(1)
+		go func() {
+			defer processor.wg.Done()
+			processor.work(ctx, mux)
+		}()
+	}
+
+	return nil
+}
+
+// Shutdown stops fetching new tasks and waits for the active ones, their
+// context is cancelled once the shutdown timeout is over.
+func (processor *MemoryTaskProcessor) Shutdown() {
+	close(processor.stop)
+
+	done := make(chan struct{})
+	go func() {
+		processor.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(memoryShutdownTimeout):
+		processor.cancel()
+		<-done
+	}
+	processor.cancel()
+	processor.broker.removeServer(processor.info.ID)
+}
+
+func (processor *MemoryTaskProcessor) sendHeartbeats() {
+	ticker := time.NewTicker(memoryHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-processor.stop:
+			return
+		case <-ticker.C:
+			processor.broker.heartbeat(processor.info)
+		}
+	}
+}
+
+func (processor *MemoryTaskProcessor) work(ctx context.Context, handler asynq.Handler) {
+	for {
+		select {
+		case <-processor.stop:
+			return
+		default:
+		}
+
+		info := processor.broker.dequeue(queuePriority)
+		if info == nil {
+			select {
+			case <-processor.stop:
+				return
+			case <-processor.broker.available:
+			case <-time.After(memoryPollInterval):
+			}
+			continue
+		}
+
+		// another worker may find work as well
+		processor.broker.signal()
+
+		task := asynq.NewTask(info.Type, info.Payload)
+		err := processTask(withTaskInfo(ctx, info), handler, task)
+
+		var delay time.Duration
+		if err != nil {
+			logTaskError(ctx, task, err)
+			delay = retryDelay(info.Retried, err, task)
+		}
+
+		processor.broker.done(info.ID, err, delay)
+	}
+}
+
+// processTask turns a panic of the handler into a task failure like asynq.
+func processTask(ctx context.Context, handler asynq.Handler, task *asynq.Task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return handler.ProcessTask(ctx, task)
+}
+
+func withTaskInfo(ctx context.Context, info *asynq.TaskInfo) context.Context {
+	ctx = context.WithValue(ctx, taskIDKey, info.ID)
+	ctx = context.WithValue(ctx, retryCountKey, info.Retried)
+	ctx = context.WithValue(ctx, maxRetryKey, info.MaxRetry)
+	return context.WithValue(ctx, queueNameKey, info.Queue)
+}
+
+// getTaskID returns the id of the task being processed by either processor.
+func getTaskID(ctx context.Context) string {
+	if id, ok := ctx.Value(taskIDKey).(string); ok {
+		return id
+	}
+	id, _ := asynq.GetTaskID(ctx)
+	return id
+}
+
+// getRetryCount returns how many times the task being processed was retried.
+func getRetryCount(ctx context.Context) int {
+	if n, ok := ctx.Value(retryCountKey).(int); ok {
+		return n
+	}
+	n, _ := asynq.GetRetryCount(ctx)
+	return n
+}
+
+// getMaxRetry returns how many times the task being processed may be retried.
+func getMaxRetry(ctx context.Context) int {
+	if n, ok := ctx.Value(maxRetryKey).(int); ok {
+		return n
+	}
+	n, _ := asynq.GetMaxRetry(ctx)
+	return n
+}
+
+// getQueueName returns the queue of the task being processed.
+func getQueueName(ctx context.Context) string {
+	if name, ok := ctx.Value(queueNameKey).(string); ok {
+		return name
+	}
+	name, _ := asynq.GetQueueName(ctx)
+	return name
+}
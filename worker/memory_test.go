@@ -0,0 +1,206 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+// startMemoryWorker runs one worker of a memory processor with the handler
+// instead of the task handlers.
+func startMemoryWorker(t *testing.T, broker *MemoryBroker, handler asynq.Handler) {
+	processor := &MemoryTaskProcessor{
+		broker: broker,
+		stop:   make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		processor.work(ctx, handler)
+	}()
+
+	t.Cleanup(func() {
+		close(processor.stop)
+		cancel()
+		wg.Wait()
+	})
+}
+
+func enqueueMemoryTask(t *testing.T, broker *MemoryBroker, taskType string, opt ...asynq.Option) {
+	distributor := NewMemoryTaskDistributor(broker)
+	err := distributor.DistributeTask(context.Background(), taskType, []byte(`{}`), opt...)
+	require.NoError(t, err)
+}
+
+func listMemoryTasks(t *testing.T, broker *MemoryBroker, queue string, state asynq.TaskState) []*asynq.TaskInfo {
+	tasks, err := NewMemoryTaskInspector(broker).ListTasks(queue, state, 1, 100)
+	require.NoError(t, err)
+	return tasks
+}
+
+func TestMemoryBrokerProcessesTasks(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	processed := make(chan string, 10)
+	startMemoryWorker(t, broker, asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		processed <- task.Type()
+		switch task.Type() {
+		case "task:skip":
+			return fmt.Errorf("bad payload: %w", asynq.SkipRetry)
+		case "task:panic":
+			panic("handler bug")
+		}
+		return nil
+	}))
+
+	enqueueMemoryTask(t, broker, "task:ok")
+	enqueueMemoryTask(t, broker, "task:skip", asynq.MaxRetry(5))
+	enqueueMemoryTask(t, broker, "task:panic", asynq.MaxRetry(0))
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-processed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("tasks were not processed")
+		}
+	}
+
+	// done is recorded right after the handler returns
+	require.Eventually(t, func() bool {
+		return len(listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateArchived)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	archived := listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateArchived)
+	for _, info := range archived {
+		require.NotEqual(t, "task:ok", info.Type)
+		require.Zero(t, info.Retried)
+	}
+	require.Empty(t, listMemoryTasks(t, broker, QueueDefault, asynq.TaskStatePending))
+}
+
+func TestMemoryBrokerRetry(t *testing.T) {
+	broker := NewMemoryBroker()
+	enqueueMemoryTask(t, broker, "task:flaky", asynq.MaxRetry(1))
+
+	info := broker.dequeue(queuePriority)
+	require.NotNil(t, info)
+	require.Equal(t, asynq.TaskStateActive, info.State)
+	broker.done(info.ID, errors.New("temporary failure"), 50*time.Millisecond)
+
+	retry := listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateRetry)
+	require.Len(t, retry, 1)
+	require.Equal(t, 1, retry[0].Retried)
+	require.Equal(t, "temporary failure", retry[0].LastErr)
+
+	// the task waits for its retry delay
+	require.Nil(t, broker.dequeue(queuePriority))
+	time.Sleep(60 * time.Millisecond)
+
+	info = broker.dequeue(queuePriority)
+	require.NotNil(t, info)
+	require.Equal(t, 1, info.Retried)
+
+	// out of retries the task is archived
+	broker.done(info.ID, errors.New("temporary failure"), 50*time.Millisecond)
+	require.Len(t, listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateArchived), 1)
+	require.Nil(t, broker.dequeue(queuePriority))
+}
+
+func TestMemoryBrokerProcessIn(t *testing.T) {
+	broker := NewMemoryBroker()
+	enqueueMemoryTask(t, broker, "task:later", asynq.ProcessIn(50*time.Millisecond))
+
+	scheduled := listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateScheduled)
+	require.Len(t, scheduled, 1)
+	require.Nil(t, broker.dequeue(queuePriority))
+
+	time.Sleep(60 * time.Millisecond)
+
+	info := broker.dequeue(queuePriority)
+	require.NotNil(t, info)
+	require.Equal(t, "task:later", info.Type)
+}
+
+func TestMemoryBrokerQueuePriority(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		enqueueMemoryTask(t, broker, "task:critical", asynq.Queue(QueueCritical))
+		enqueueMemoryTask(t, broker, "task:default", asynq.Queue(QueueDefault))
+	}
+
+	// the first task of a queue is the oldest one
+	var critical int
+	var lastSeq int64
+	for i := 0; i < n; i++ {
+		info := broker.dequeue(queuePriority)
+		require.NotNil(t, info)
+		if info.Queue == QueueCritical {
+			critical++
+		}
+
+		seq := broker.tasks[info.ID].seq
+		broker.done(info.ID, nil, 0)
+		if info.Queue == QueueCritical {
+			require.Greater(t, seq, lastSeq)
+			lastSeq = seq
+		}
+	}
+
+	// critical is weighted 10 against 5, it is picked about two thirds of
+	// the time but default is never starved
+	require.InDelta(t, n*2/3, critical, 100)
+	require.Less(t, critical, n)
+}
+
+func TestMemoryBrokerPausedQueue(t *testing.T) {
+	broker := NewMemoryBroker()
+	inspector := NewMemoryTaskInspector(broker)
+
+	enqueueMemoryTask(t, broker, "task:critical", asynq.Queue(QueueCritical))
+	require.NoError(t, inspector.PauseQueue(QueueCritical))
+	require.Nil(t, broker.dequeue(queuePriority))
+
+	require.NoError(t, inspector.ResumeQueue(QueueCritical))
+	require.NotNil(t, broker.dequeue(queuePriority))
+}
+
+func TestMemoryBrokerPruneArchive(t *testing.T) {
+	broker := NewMemoryBroker()
+	broker.maxArchiveSize = 2
+	broker.archiveTTL = time.Hour
+
+	archive := func(taskType string) string {
+		enqueueMemoryTask(t, broker, taskType, asynq.MaxRetry(0))
+		info := broker.dequeue(queuePriority)
+		require.NotNil(t, info)
+		broker.done(info.ID, errors.New("failed"), 0)
+		return info.ID
+	}
+
+	expired := archive("task:expired")
+	broker.tasks[expired].info.LastFailedAt = time.Now().Add(-2 * time.Hour)
+
+	oldest := archive("task:oldest")
+	archive("task:newer")
+	require.NotContains(t, broker.tasks, expired)
+	require.Contains(t, broker.tasks, oldest)
+
+	archive("task:newest")
+	require.NotContains(t, broker.tasks, oldest)
+
+	archived := listMemoryTasks(t, broker, QueueDefault, asynq.TaskStateArchived)
+	require.Len(t, archived, 2)
+	require.Equal(t, "task:newer", archived[0].Type)
+	require.Equal(t, "task:newest", archived[1].Type)
+}
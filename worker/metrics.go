@@ -0,0 +1,33 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/scipiia/snippetbox/metrics"
+)
+
+// metricsMiddleware counts processed, failed and retried tasks per type and
+// queue for both processors.
+func metricsMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		startTime := time.Now()
+
+		err := handler.ProcessTask(ctx, task)
+
+		queue := getQueueName(ctx)
+		metrics.TasksProcessed.WithLabelValues(task.Type(), queue).Inc()
+		metrics.TaskDuration.WithLabelValues(task.Type(), queue).Observe(time.Since(startTime).Seconds())
+
+		if err != nil {
+			metrics.TasksFailed.WithLabelValues(task.Type(), queue).Inc()
+			if !errors.Is(err, asynq.SkipRetry) && getRetryCount(ctx) < getMaxRetry(ctx) {
+				metrics.TasksRetried.WithLabelValues(task.Type(), queue).Inc()
+			}
+		}
+
+		return err
+	})
+}
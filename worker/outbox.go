@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+const outboxBatchSize = 100
+
+// OutboxTaskDistributor writes tasks to the outbox table instead of Redis.
+// Built on the queries of a running transaction it makes the enqueue part
+// of that transaction; OutboxRelay publishes the rows after commit.
+type OutboxTaskDistributor struct {
+	querier db.Querier
+}
+
+func NewOutboxTaskDistributor(querier db.Querier) TaskDistributor {
+	return &OutboxTaskDistributor{
+		querier: querier,
+	}
+}
+
+func (distributor *OutboxTaskDistributor) DistributeTask(
+	ctx context.Context,
+	taskType string,
+	payload []byte,
+	opt ...asynq.Option,
+) error {
+	ctx, payload = propagateRequestID(ctx, payload)
+	ctx, span, payload := startEnqueueSpan(ctx, taskType, payload)
+	defer span.End()
+
+	arg := db.CreateOutboxTaskParams{
+		TaskType: taskType,
+		Payload:  payload,
+		Queue:    QueueDefault,
+		MaxRetry: defaultMaxRetry,
+	}
+
+	for _, o := range opt {
+		switch o.Type() {
+		case asynq.QueueOpt:
+			arg.Queue = o.Value().(string)
+		case asynq.MaxRetryOpt:
+			arg.MaxRetry = int32(o.Value().(int))
+		case asynq.ProcessInOpt:
+			arg.ProcessAt = sql.NullTime{
+				Time:  time.Now().Add(o.Value().(time.Duration)),
+				Valid: true,
+			}
+		case asynq.ProcessAtOpt:
+			arg.ProcessAt = sql.NullTime{
+				Time:  o.Value().(time.Time),
+				Valid: true,
+			}
+		default:
+			return spanError(span, fmt.Errorf("unsupported outbox task option: %s", o.String()))
+		}
+	}
+
+	task, err := distributor.querier.CreateOutboxTask(ctx, arg)
+	if err != nil {
+		return spanError(span, fmt.Errorf("failed to create outbox task: %w", err))
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.TaskType).
+		Int64("outbox_id", task.ID).
+		Str("queue", task.Queue).
+		Msg("stored task in outbox")
+
+	return nil
+}
+
+// OutboxRelay moves pending outbox rows to the task queue. Delivery is at
+// least once: a row published right before a failed commit is sent again,
+// the outbox id doubles as the asynq task id so such duplicates are dropped
+// while the first copy is still known to Redis.
+type OutboxRelay struct {
+	store       db.Store
+	distributor TaskDistributor
+	interval    time.Duration
+	retention   time.Duration
+}
+
+func NewOutboxRelay(store db.Store, distributor TaskDistributor, interval, retention time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+		interval:    interval,
+		retention:   retention,
+	}
+}
+
+// Start relays until ctx is cancelled.
+func (relay *OutboxRelay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := relay.Relay(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to relay outbox")
+		}
+
+		if err := relay.Prune(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to prune outbox")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes due rows batch by batch until none are left. Failed rows
+// are put back with a backoff, so they don't hold up the rows behind them.
+func (relay *OutboxRelay) Relay(ctx context.Context) error {
+	for {
+		result, err := relay.store.PublishOutboxTx(ctx, db.PublishOutboxTxParams{
+			Limit:   outboxBatchSize,
+			Publish: relay.publish(ctx),
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.Published > 0 || result.Failed > 0 {
+			log.Info().Int("published", result.Published).
+				Int("failed", result.Failed).
+				Int("dead", result.Dead).
+				Msg("relayed outbox")
+		}
+
+		if result.Published+result.Failed < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// Prune removes rows published longer than retention ago.
+func (relay *OutboxRelay) Prune(ctx context.Context) error {
+	_, err := relay.store.DeletePublishedOutboxTasks(ctx, time.Now().Add(-relay.retention))
+	return err
+}
+
+func (relay *OutboxRelay) publish(ctx context.Context) func(task db.Outbox) error {
+	return func(task db.Outbox) error {
+		opts := []asynq.Option{
+			asynq.TaskID(fmt.Sprintf("outbox:%d", task.ID)),
+			asynq.Queue(task.Queue),
+			asynq.MaxRetry(int(task.MaxRetry)),
+		}
+		if task.ProcessAt.Valid {
+			opts = append(opts, asynq.ProcessAt(task.ProcessAt.Time))
+		}
+
+		err := relay.distributor.DistributeTask(ctx, task.TaskType, task.Payload, opts...)
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return err
+	}
+}
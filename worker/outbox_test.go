@@ -0,0 +1,162 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	mockdb "github.com/scipiia/snippetbox/db/mock"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+type distributedTask struct {
+	taskType string
+	payload  []byte
+	opts     []asynq.Option
+}
+
+// fakeDistributor records tasks and fails the types listed in errs
+type fakeDistributor struct {
+	tasks []distributedTask
+	errs  map[string]error
+}
+
+func (distributor *fakeDistributor) DistributeTask(ctx context.Context, taskType string, payload []byte, opt ...asynq.Option) error {
+	if err := distributor.errs[taskType]; err != nil {
+		return err
+	}
+	distributor.tasks = append(distributor.tasks, distributedTask{taskType: taskType, payload: payload, opts: opt})
+	return nil
+}
+
+// publishBatch stubs PublishOutboxTx with a store that hands out the batches
+// in order and counts the results like the SQL store does.
+func publishBatch(batches ...[]db.Outbox) func(ctx context.Context, arg db.PublishOutboxTxParams) (db.PublishOutboxTxResult, error) {
+	return func(ctx context.Context, arg db.PublishOutboxTxParams) (db.PublishOutboxTxResult, error) {
+		var result db.PublishOutboxTxResult
+		if len(batches) == 0 {
+			return result, nil
+		}
+
+		batch := batches[0]
+		batches = batches[1:]
+		for _, task := range batch {
+			if err := arg.Publish(task); err != nil {
+				result.Failed++
+				continue
+			}
+			result.Published++
+		}
+		return result, nil
+	}
+}
+
+func newOutboxBatch(firstID int64, n int, taskType string) []db.Outbox {
+	batch := make([]db.Outbox, n)
+	for i := range batch {
+		batch[i] = db.Outbox{
+			ID:       firstID + int64(i),
+			TaskType: taskType,
+			Payload:  json.RawMessage(`{}`),
+			Queue:    QueueDefault,
+			MaxRetry: defaultMaxRetry,
+		}
+	}
+	return batch
+}
+
+func TestOutboxRelayPublishes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	processAt := time.Now().Add(time.Minute).Truncate(time.Second)
+	task := db.Outbox{
+		ID:        42,
+		TaskType:  TaskSendVerifyEmailType,
+		Payload:   json.RawMessage(`{"username":"alice"}`),
+		Queue:     QueueCritical,
+		MaxRetry:  3,
+		ProcessAt: sql.NullTime{Time: processAt, Valid: true},
+	}
+
+	store := mockdb.NewMockStore(controller)
+	store.EXPECT().PublishOutboxTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(publishBatch([]db.Outbox{task}))
+
+	distributor := &fakeDistributor{}
+	relay := NewOutboxRelay(store, distributor, time.Second, time.Hour)
+	require.NoError(t, relay.Relay(context.Background()))
+
+	require.Len(t, distributor.tasks, 1)
+	published := distributor.tasks[0]
+	require.Equal(t, task.TaskType, published.taskType)
+	require.JSONEq(t, string(task.Payload), string(published.payload))
+
+	opts := map[asynq.OptionType]interface{}{}
+	for _, o := range published.opts {
+		opts[o.Type()] = o.Value()
+	}
+	require.Equal(t, fmt.Sprintf("outbox:%d", task.ID), opts[asynq.TaskIDOpt])
+	require.Equal(t, task.Queue, opts[asynq.QueueOpt])
+	require.Equal(t, 3, opts[asynq.MaxRetryOpt])
+	require.Equal(t, processAt, opts[asynq.ProcessAtOpt])
+}
+
+func TestOutboxRelayContinuesPastFailures(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// a full batch of failing rows must not hold up the rows behind it
+	failing := newOutboxBatch(1, outboxBatchSize, "task:failing")
+	pending := newOutboxBatch(outboxBatchSize+1, 3, TaskSendVerifyEmailType)
+
+	store := mockdb.NewMockStore(controller)
+	store.EXPECT().PublishOutboxTx(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(publishBatch(failing, pending))
+
+	distributor := &fakeDistributor{
+		errs: map[string]error{"task:failing": errors.New("unknown task")},
+	}
+	relay := NewOutboxRelay(store, distributor, time.Second, time.Hour)
+	require.NoError(t, relay.Relay(context.Background()))
+
+	require.Len(t, distributor.tasks, len(pending))
+}
+
+func TestOutboxRelayDuplicate(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := mockdb.NewMockStore(controller)
+	store.EXPECT().PublishOutboxTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.PublishOutboxTxParams) (db.PublishOutboxTxResult, error) {
+			// a row published before a failed commit is already known to Redis
+			require.NoError(t, arg.Publish(newOutboxBatch(1, 1, "task:duplicate")[0]))
+			return db.PublishOutboxTxResult{Published: 1}, nil
+		})
+
+	distributor := &fakeDistributor{
+		errs: map[string]error{"task:duplicate": asynq.ErrTaskIDConflict},
+	}
+	relay := NewOutboxRelay(store, distributor, time.Second, time.Hour)
+	require.NoError(t, relay.Relay(context.Background()))
+}
+
+func TestOutboxRelayStoreError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := mockdb.NewMockStore(controller)
+	store.EXPECT().PublishOutboxTx(gomock.Any(), gomock.Any()).Times(1).
+		Return(db.PublishOutboxTxResult{}, errors.New("connection refused"))
+
+	relay := NewOutboxRelay(store, &fakeDistributor{}, time.Second, time.Hour)
+	require.Error(t, relay.Relay(context.Background()))
+}
@@ -2,9 +2,11 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/blob"
 	db "github.com/scipiia/snippetbox/db/sqlc"
 )
 
@@ -13,41 +15,103 @@ const (
 	QueueDefault  = "default"
 )
 
+// queuePriority weights how often each queue is picked, it is not strict so
+// the default queue is served even while critical tasks are waiting
+var queuePriority = map[string]int{
+	QueueCritical: 10,
+	QueueDefault:  5,
+}
+
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDispatchWebhookEvent(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error
+	ProcessTaskImportSnippets(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error
+	ProcessTaskNotifySnippetComment(ctx context.Context, task *asynq.Task) error
+}
+
+// taskHandler holds what the task handlers depend on, it is shared by the
+// Redis and the in-memory processor
+type taskHandler struct {
+	store           db.Store
+	distributor     TaskDistributor
+	blobStore       blob.Store
+	exportRetention time.Duration
+}
+
+// newServeMux routes every task type to its handler
+func newServeMux(handler *taskHandler) *asynq.ServeMux {
+	mux := asynq.NewServeMux()
+	mux.Use(requestIDMiddleware, tracingMiddleware, metricsMiddleware)
+
+	mux.HandleFunc(TaskSendVerifyEmailType, handler.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskDispatchWebhookEventType, handler.ProcessTaskDispatchWebhookEvent)
+	mux.HandleFunc(TaskDeliverWebhookType, handler.ProcessTaskDeliverWebhook)
+	mux.HandleFunc(TaskImportSnippetsType, handler.ProcessTaskImportSnippets)
+	mux.HandleFunc(TaskExportUserDataType, handler.ProcessTaskExportUserData)
+	mux.HandleFunc(TaskNotifySnippetCommentType, handler.ProcessTaskNotifySnippetComment)
+
+	return mux
 }
 
 type RedisTaskProcessor struct {
+	taskHandler
 	server *asynq.Server
-	store  db.Store
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) TaskProcessor {
+func NewRedisTaskProcessor(
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+	distributor TaskDistributor,
+	blobStore blob.Store,
+	exportRetention time.Duration,
+) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
-			Queues: map[string]int{
-				QueueCritical: 10,
-				QueueDefault:  5,
-			},
-			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
-				log.Error().Err(err).Str("type", task.Type()).Bytes("payload", task.Payload()).Msg("process task failed")
-			}),
-			Logger: NewLogger(),
+			Queues:         queuePriority,
+			ErrorHandler:   asynq.ErrorHandlerFunc(logTaskError),
+			RetryDelayFunc: retryDelay,
+			Logger:         NewLogger(),
 		},
 	)
 
 	return &RedisTaskProcessor{
+		taskHandler: taskHandler{
+			store:           store,
+			distributor:     distributor,
+			blobStore:       blobStore,
+			exportRetention: exportRetention,
+		},
 		server: server,
-		store:  store,
 	}
 }
 
 func (processor *RedisTaskProcessor) Start() error {
-	mux := asynq.NewServeMux()
+	return processor.server.Start(newServeMux(&processor.taskHandler))
+}
 
-	mux.HandleFunc(TaskSendVerifyEmailType, processor.ProcessTaskSendVerifyEmail)
+// Shutdown waits for the active tasks to finish and stops the processor.
+func (processor *RedisTaskProcessor) Shutdown() {
+	processor.server.Shutdown()
+}
+
+// retryDelay backs off webhook deliveries exponentially and keeps the asynq
+// default for every other task.
+func retryDelay(n int, err error, task *asynq.Task) time.Duration {
+	if task.Type() == TaskDeliverWebhookType {
+		return webhookRetryDelay(n)
+	}
+	return asynq.DefaultRetryDelayFunc(n, err, task)
+}
 
-	return processor.server.Start(mux)
+func logTaskError(ctx context.Context, task *asynq.Task, err error) {
+	log.Error().Err(err).
+		Str("request_id", getPayloadRequestID(task.Payload())).
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Msg("process task failed")
 }
@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/scipiia/snippetbox/requestid"
+)
+
+// requestIDKey is the payload field with the id of the request which caused
+// the task. asynq has no task headers, so it travels in the payload.
+const requestIDKey = "request_id"
+
+type payloadRequestID struct {
+	RequestID string `json:"request_id"`
+}
+
+func getPayloadRequestID(payload []byte) string {
+	var p payloadRequestID
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return ""
+	}
+	return p.RequestID
+}
+
+// propagateRequestID writes the request id of ctx to the payload. Without an
+// id in ctx, like in the outbox relay, ctx gets the id the payload carries.
+func propagateRequestID(ctx context.Context, payload []byte) (context.Context, []byte) {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		if id = getPayloadRequestID(payload); id != "" {
+			ctx = requestid.NewContext(ctx, id)
+		}
+		return ctx, payload
+	}
+
+	return ctx, setPayloadField(payload, requestIDKey, id)
+}
+
+// requestIDMiddleware gives the handlers a logger with the request id of the
+// task, a task without one gets a new id.
+func requestIDMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		id := getPayloadRequestID(task.Payload())
+		if id == "" {
+			id = requestid.New()
+		}
+		ctx = requestid.NewContext(ctx, id)
+
+		logger := log.Ctx(ctx).With().
+			Str("task_type", task.Type()).
+			Str("task_id", getTaskID(ctx)).
+			Logger()
+
+		return handler.ProcessTask(logger.WithContext(ctx), task)
+	})
+}
@@ -0,0 +1,222 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+)
+
+const TaskDeliverWebhookType = "task:deliver_webhook"
+
+const (
+	WebhookSignatureHeader = "X-Snippetbox-Signature"
+	WebhookEventHeader     = "X-Snippetbox-Event"
+	WebhookDeliveryHeader  = "X-Snippetbox-Delivery"
+
+	webhookMaxRetry = 8
+	// a webhook is disabled after this many failed attempts in a row
+	webhookMaxFailures  = 20
+	webhookTimeout      = 10 * time.Second
+	webhookBaseDelay    = 10 * time.Second
+	webhookMaxDelay     = time.Hour
+	webhookMaxDrainSize = 64 << 10
+)
+
+// webhookClient only connects to public addresses, checked after the host is
+// resolved so a rebound DNS name can't reach internal services. Redirects are
+// not followed, a 3xx response fails the delivery.
+var webhookClient = &http.Client{
+	Timeout: webhookTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: webhookTimeout,
+			Control: checkWebhookDial,
+		}).DialContext,
+		TLSHandshakeTimeout: webhookTimeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func checkWebhookDial(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !util.IsPublicIP(ip) {
+		return fmt.Errorf("cannot dial %s: %w", address, util.ErrNonPublicAddress)
+	}
+	return nil
+}
+
+type PayloadDeliverWebhook struct {
+	WebhookID int64           `json:"webhook_id"`
+	Event     string          `json:"event"`
+	Body      json.RawMessage `json:"body"`
+}
+
+// DeliverWebhookOptions are the options every webhook delivery is enqueued with.
+func DeliverWebhookOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(webhookMaxRetry),
+		asynq.Queue(QueueDefault),
+	}
+}
+
+// SignWebhookBody returns the value of the signature header: the hex encoded
+// HMAC-SHA256 of the body keyed with the webhook secret.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay backs off exponentially: 10s, 20s, 40s, ... up to an hour.
+func webhookRetryDelay(n int) time.Duration {
+	delay := webhookBaseDelay
+	for i := 0; i < n && delay < webhookMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxDelay {
+		delay = webhookMaxDelay
+	}
+	return delay
+}
+
+// DistributeTaskDeliverWebhook enqueues one delivery of an event to a webhook.
+func DistributeTaskDeliverWebhook(
+	ctx context.Context,
+	distributor TaskDistributor,
+	payload *PayloadDeliverWebhook,
+	opt ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskDeliverWebhookType, jsonPayload, opt...)
+}
+
+// ProcessTaskDeliverWebhook POSTs the signed body to the webhook endpoint and
+// records the attempt. Failed attempts are retried with exponential backoff
+// until the webhook is disabled for failing too often.
+func (processor *taskHandler) ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDeliverWebhook
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	webhook, err := processor.store.GetWebhook(ctx, payload.WebhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("webhook doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	if !webhook.IsActive {
+		return fmt.Errorf("webhook is disabled: %w", asynq.SkipRetry)
+	}
+
+	retried := getRetryCount(ctx)
+	deliveryID := getTaskID(ctx)
+
+	statusCode, deliverErr := deliverWebhook(ctx, webhook, deliveryID, payload)
+
+	arg := db.CreateWebhookDeliveryParams{
+		WebhookID: webhook.ID,
+		Event:     payload.Event,
+		Payload:   payload.Body,
+		Attempt:   int32(retried + 1),
+		Succeeded: deliverErr == nil,
+	}
+	if statusCode != 0 {
+		arg.StatusCode = sql.NullInt32{Int32: int32(statusCode), Valid: true}
+	}
+	if deliverErr != nil {
+		arg.Error = sql.NullString{String: deliverErr.Error(), Valid: true}
+	}
+
+	_, err = processor.store.CreateWebhookDelivery(ctx, arg)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Int64("webhook_id", webhook.ID).Msg("failed to record webhook delivery")
+	}
+
+	if deliverErr == nil {
+		if webhook.FailureCount > 0 {
+			if err := processor.store.ResetWebhookFailures(ctx, webhook.ID); err != nil {
+				log.Ctx(ctx).Error().Err(err).Int64("webhook_id", webhook.ID).Msg("failed to reset webhook failures")
+			}
+		}
+
+		log.Ctx(ctx).Info().Str("type", task.Type()).
+			Int64("webhook_id", webhook.ID).
+			Str("event", payload.Event).
+			Int("status_code", statusCode).
+			Msg("processor task")
+		return nil
+	}
+
+	webhook, err = processor.store.RecordWebhookFailure(ctx, db.RecordWebhookFailureParams{
+		MaxFailures: webhookMaxFailures,
+		ID:          webhook.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record webhook failure: %w", err)
+	}
+
+	if !webhook.IsActive {
+		log.Ctx(ctx).Warn().Int64("webhook_id", webhook.ID).
+			Int32("failure_count", webhook.FailureCount).
+			Msg("webhook disabled after repeated failures")
+		return fmt.Errorf("failed to deliver webhook, webhook disabled: %s: %w", deliverErr, asynq.SkipRetry)
+	}
+
+	return fmt.Errorf("failed to deliver webhook: %w", deliverErr)
+}
+
+func deliverWebhook(ctx context.Context, webhook db.Webhook, deliveryID string, payload PayloadDeliverWebhook) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(payload.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Snippetbox-Webhook")
+	req.Header.Set(WebhookEventHeader, payload.Event)
+	req.Header.Set(WebhookDeliveryHeader, deliveryID)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookBody(webhook.Secret, payload.Body))
+
+	rsp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+
+	// the body is never stored, the endpoint could echo anything back
+	io.Copy(io.Discard, io.LimitReader(rsp.Body, webhookMaxDrainSize))
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return rsp.StatusCode, fmt.Errorf("unexpected status %d", rsp.StatusCode)
+	}
+	return rsp.StatusCode, nil
+}
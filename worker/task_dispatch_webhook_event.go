@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+)
+
+const TaskDispatchWebhookEventType = "task:dispatch_webhook_event"
+
+// PayloadDispatchWebhookEvent is an event which is fanned out to every active
+// webhook of the owner subscribed to it.
+type PayloadDispatchWebhookEvent struct {
+	Event      string          `json:"event"`
+	Owner      string          `json:"owner"`
+	Data       json.RawMessage `json:"data"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// webhookEventBody is the JSON document POSTed to webhook endpoints
+type webhookEventBody struct {
+	Event      string          `json:"event"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// DistributeTaskDispatchWebhookEvent enqueues an event to fan out to the webhooks subscribed to it.
+func DistributeTaskDispatchWebhookEvent(
+	ctx context.Context,
+	distributor TaskDistributor,
+	payload *PayloadDispatchWebhookEvent,
+	opt ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskDispatchWebhookEventType, jsonPayload, opt...)
+}
+
+// ProcessTaskDispatchWebhookEvent enqueues one delivery task per subscribed
+// webhook. A retry after a partial failure may deliver the event twice to
+// some endpoints, receivers must tolerate duplicates anyway.
+func (processor *taskHandler) ProcessTaskDispatchWebhookEvent(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDispatchWebhookEvent
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	webhooks, err := processor.store.ListActiveWebhooksForEvent(ctx, db.ListActiveWebhooksForEventParams{
+		Owner: payload.Owner,
+		Event: payload.Event,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	body, err := json.Marshal(webhookEventBody{
+		Event:      payload.Event,
+		OccurredAt: payload.OccurredAt,
+		Data:       payload.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook body: %w", asynq.SkipRetry)
+	}
+
+	for _, webhook := range webhooks {
+		deliverPayload := &PayloadDeliverWebhook{
+			WebhookID: webhook.ID,
+			Event:     payload.Event,
+			Body:      body,
+		}
+
+		err := DistributeTaskDeliverWebhook(ctx, processor.distributor, deliverPayload, DeliverWebhookOptions()...)
+		if err != nil {
+			return fmt.Errorf("failed to distribute webhook delivery: %w", err)
+		}
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Str("event", payload.Event).
+		Str("owner", payload.Owner).
+		Int("webhooks", len(webhooks)).
+		Msg("processor task")
+
+	return nil
+}
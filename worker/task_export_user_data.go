@@ -0,0 +1,301 @@
+package worker
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+)
+
+const TaskExportUserDataType = "task:export_user_data"
+
+const (
+	exportPageSize = 100
+	exportMaxRetry = 5
+)
+
+type PayloadExportUserData struct {
+	ExportID int64 `json:"export_id"`
+}
+
+// ExportUserDataOptions are the options every export is enqueued with.
+func ExportUserDataOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(exportMaxRetry),
+		asynq.Queue(QueueDefault),
+	}
+}
+
+// the files of the archive hold no secrets: no password hash, refresh
+// tokens or webhook secrets
+type exportProfile struct {
+	Name              string    `json:"name"`
+	FullName          string    `json:"full_name"`
+	Email             string    `json:"email"`
+	Role              string    `json:"role"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	Created           time.Time `json:"created"`
+}
+
+type exportSession struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIp  string    `json:"client_ip"`
+	IsBlocked bool      `json:"is_blocked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Created   time.Time `json:"created"`
+}
+
+type exportWebhook struct {
+	ID       int64     `json:"id"`
+	Url      string    `json:"url"`
+	Events   []string  `json:"events"`
+	IsActive bool      `json:"is_active"`
+	Created  time.Time `json:"created"`
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ExportBlobKey is where the archive of an export is stored.
+func ExportBlobKey(exportID int64) string {
+	return fmt.Sprintf("exports/%d.zip", exportID)
+}
+
+// DistributeTaskExportUserData enqueues the archive of an export.
+func DistributeTaskExportUserData(
+	ctx context.Context,
+	distributor TaskDistributor,
+	payload *PayloadExportUserData,
+	opt ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskExportUserDataType, jsonPayload, opt...)
+}
+
+// ProcessTaskExportUserData writes everything the user owns to a zip archive
+// in the blob store. The export is marked failed once the retries run out.
+func (processor *taskHandler) ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadExportUserData
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	export, err := processor.store.GetExport(ctx, payload.ExportID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("export doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get export: %w", err)
+	}
+
+	if export.Status != util.ExportStatusPending {
+		// a duplicate of a finished task
+		return nil
+	}
+
+	key := ExportBlobKey(export.ID)
+	size, err := processor.writeExport(ctx, export.Owner, key)
+	if err != nil {
+		if getRetryCount(ctx) >= exportMaxRetry {
+			_, finishErr := processor.store.FinishExport(ctx, db.FinishExportParams{
+				Status: util.ExportStatusFailed,
+				Error:  sql.NullString{String: err.Error(), Valid: true},
+				ID:     export.ID,
+			})
+			if finishErr != nil {
+				log.Ctx(ctx).Error().Err(finishErr).Int64("export_id", export.ID).Msg("failed to mark export failed")
+			}
+		}
+		return err
+	}
+
+	_, err = processor.store.FinishExport(ctx, db.FinishExportParams{
+		Status:    util.ExportStatusCompleted,
+		BlobKey:   sql.NullString{String: key, Valid: true},
+		Size:      size,
+		ExpiresAt: sql.NullTime{Time: time.Now().Add(processor.exportRetention), Valid: true},
+		ID:        export.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish export: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Int64("export_id", export.ID).
+		Str("owner", export.Owner).
+		Int64("size", size).
+		Msg("processor task")
+
+	return nil
+}
+
+// writeExport builds the archive in a temporary file and stores it as key.
+func (processor *taskHandler) writeExport(ctx context.Context, owner string, key string) (int64, error) {
+	file, err := os.CreateTemp("", "snippetbox-export-*.zip")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	if err := processor.writeExportArchive(ctx, archive, owner); err != nil {
+		return 0, err
+	}
+	if err := archive.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if _, err := file.Seek(0, 0); err != nil {
+		return 0, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	size, err := processor.blobStore.Put(ctx, key, file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to store archive: %w", err)
+	}
+
+	return size, nil
+}
+
+func (processor *taskHandler) writeExportArchive(ctx context.Context, archive *zip.Writer, owner string) error {
+	user, err := processor.store.GetUser(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	err = writeExportJSON(archive, "profile.json", exportProfile{
+		Name:              user.Name,
+		FullName:          user.FullName,
+		Email:             user.Email,
+		Role:              user.Role,
+		PasswordChangedAt: user.PasswordChangedAt,
+		Created:           user.Created,
+	})
+	if err != nil {
+		return err
+	}
+
+	accounts, err := processor.store.ListAccountsByLogin(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+	if err := writeExportJSON(archive, "accounts.json", accounts); err != nil {
+		return err
+	}
+
+	snippets := []db.Snippet{}
+	for _, account := range accounts {
+		for page := int32(0); ; page++ {
+			items, err := processor.store.ListSnippets(ctx, db.ListSnippetsParams{
+				AccountID: account.ID,
+				Limit:     exportPageSize,
+				Offset:    page * exportPageSize,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list snippets: %w", err)
+			}
+
+			for _, snippet := range items {
+				files, err := processor.store.ListSnippetFiles(ctx, snippet.ID)
+				if err != nil {
+					return fmt.Errorf("failed to list snippet files: %w", err)
+				}
+
+				dir := fmt.Sprintf("snippets/%d/%d-%s", account.ID, snippet.ID, safeFilename(snippet.Title))
+				for _, file := range files {
+					w, err := archive.Create(dir + "/" + safeFilename(file.Filename))
+					if err != nil {
+						return fmt.Errorf("failed to write archive: %w", err)
+					}
+					if _, err := w.Write([]byte(file.Content)); err != nil {
+						return fmt.Errorf("failed to write archive: %w", err)
+					}
+				}
+			}
+
+			snippets = append(snippets, items...)
+			if len(items) < exportPageSize {
+				break
+			}
+		}
+	}
+	if err := writeExportJSON(archive, "snippets.json", snippets); err != nil {
+		return err
+	}
+
+	sessions, err := processor.store.ListSessionsByName(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	exportSessions := make([]exportSession, 0, len(sessions))
+	for _, session := range sessions {
+		exportSessions = append(exportSessions, exportSession{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			ClientIp:  session.ClientIp,
+			IsBlocked: session.IsBlocked,
+			ExpiresAt: session.ExpiresAt,
+			Created:   session.Created,
+		})
+	}
+	if err := writeExportJSON(archive, "sessions.json", exportSessions); err != nil {
+		return err
+	}
+
+	webhooks, err := processor.store.ListWebhooks(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	exportWebhooks := make([]exportWebhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		exportWebhooks = append(exportWebhooks, exportWebhook{
+			ID:       webhook.ID,
+			Url:      webhook.Url,
+			Events:   webhook.Events,
+			IsActive: webhook.IsActive,
+			Created:  webhook.Created,
+		})
+	}
+	return writeExportJSON(archive, "webhooks.json", exportWebhooks)
+}
+
+func writeExportJSON(archive *zip.Writer, name string, v interface{}) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func safeFilename(title string) string {
+	name := unsafeFilenameChars.ReplaceAllString(title, "_")
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	if name == "" || name == "." || name == ".." {
+		name = "snippet"
+	}
+	return name
+}
@@ -0,0 +1,225 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/scipiia/snippetbox/db/sqlc"
+	"github.com/scipiia/snippetbox/util"
+	"github.com/scipiia/snippetbox/validation"
+)
+
+const TaskImportSnippetsType = "task:import_snippets"
+
+// only the first errors are kept in the report, the failed count has them all
+const maxImportErrors = 1000
+
+type PayloadImportSnippets struct {
+	ImportID int64 `json:"import_id"`
+}
+
+// ImportSnippetsOptions are the options every import is enqueued with.
+func ImportSnippetsOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Queue(QueueDefault),
+	}
+}
+
+// DistributeTaskImportSnippets enqueues the import of an uploaded archive.
+func DistributeTaskImportSnippets(
+	ctx context.Context,
+	distributor TaskDistributor,
+	payload *PayloadImportSnippets,
+	opt ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskImportSnippetsType, jsonPayload, opt...)
+}
+
+// importReport counts what happened to the entries of an archive
+type importReport struct {
+	total   int32
+	created int32
+	updated int32
+	skipped int32
+	failed  int32
+	errors  []util.SnippetFileError
+}
+
+func (report *importReport) fail(name string, err error) {
+	report.failed++
+	if len(report.errors) < maxImportErrors {
+		report.errors = append(report.errors, util.SnippetFileError{Name: name, Error: err.Error()})
+	}
+}
+
+// ProcessTaskImportSnippets imports the snippets of an uploaded archive into
+// the account of the job. A retry starts the job over, snippets imported by
+// the failed attempt are then handled by the duplicate policy.
+func (processor *taskHandler) ProcessTaskImportSnippets(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadImportSnippets
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	snippetImport, err := processor.store.GetSnippetImport(ctx, payload.ImportID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("snippet import doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get snippet import: %w", err)
+	}
+
+	if snippetImport.Status == util.ImportStatusCompleted || snippetImport.Status == util.ImportStatusFailed {
+		// a duplicate of a finished task
+		return nil
+	}
+
+	snippetImport, err = processor.store.StartSnippetImport(ctx, snippetImport.ID)
+	if err != nil {
+		return fmt.Errorf("failed to start snippet import: %w", err)
+	}
+
+	report := &importReport{
+		errors: []util.SnippetFileError{},
+	}
+	status := util.ImportStatusCompleted
+
+	files, fileErrors, err := util.ParseSnippetArchive(snippetImport.Format, snippetImport.Archive)
+	if err != nil {
+		// the upload itself is broken, retrying won't help
+		status = util.ImportStatusFailed
+		report.errors = append(report.errors, util.SnippetFileError{Error: err.Error()})
+	}
+
+	for _, fileError := range fileErrors {
+		report.total++
+		report.fail(fileError.Name, fmt.Errorf("%s", fileError.Error))
+	}
+
+	for _, file := range files {
+		report.total++
+		err := processor.importSnippet(ctx, snippetImport, file, report)
+		if err != nil {
+			return err
+		}
+	}
+
+	jsonErrors, err := json.Marshal(report.errors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal import errors: %w", err)
+	}
+
+	_, err = processor.store.FinishSnippetImport(ctx, db.FinishSnippetImportParams{
+		Status:       status,
+		Total:        report.total,
+		CreatedCount: report.created,
+		UpdatedCount: report.updated,
+		SkippedCount: report.skipped,
+		FailedCount:  report.failed,
+		Errors:       jsonErrors,
+		ID:           snippetImport.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish snippet import: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Int64("import_id", snippetImport.ID).
+		Str("status", status).
+		Int32("created", report.created).
+		Int32("updated", report.updated).
+		Int32("skipped", report.skipped).
+		Int32("failed", report.failed).
+		Msg("processor task")
+
+	return nil
+}
+
+// importSnippet stores one file according to the duplicate policy. Invalid
+// files are added to the report, only database errors are returned.
+func (processor *taskHandler) importSnippet(ctx context.Context, snippetImport db.SnippetImport, file util.SnippetFile, report *importReport) error {
+	if err := validation.ValidateSnippetTitle(file.Title); err != nil {
+		report.fail(file.Name, fmt.Errorf("title %w", err))
+		return nil
+	}
+	if err := validation.ValidateSnippetContent(file.Content); err != nil {
+		report.fail(file.Name, fmt.Errorf("content %w", err))
+		return nil
+	}
+	if err := validation.ValidateSnippetLanguage(file.Language); err != nil {
+		report.fail(file.Name, fmt.Errorf("language %w", err))
+		return nil
+	}
+
+	// every imported file becomes a one-file snippet
+	filename := file.Filename
+	if filename == "" {
+		filename = strings.ReplaceAll(file.Title, "/", "_")
+	}
+	if err := validation.ValidateSnippetFilename(filename); err != nil {
+		report.fail(file.Name, fmt.Errorf("filename %w", err))
+		return nil
+	}
+	files := []db.SnippetFileParams{
+		{
+			Filename: filename,
+			Language: file.Language,
+			Content:  file.Content,
+		},
+	}
+
+	existing, err := processor.store.GetSnippetByTitle(ctx, db.GetSnippetByTitleParams{
+		AccountID: snippetImport.AccountID,
+		Title:     file.Title,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to get snippet: %w", err)
+	}
+
+	if err == nil {
+		if snippetImport.DuplicatePolicy != util.DuplicatePolicyOverwrite {
+			report.skipped++
+			return nil
+		}
+
+		_, err = processor.store.UpdateSnippetTx(ctx, db.UpdateSnippetTxParams{
+			UpdateSnippetParams: db.UpdateSnippetParams{
+				Language: sql.NullString{String: file.Language, Valid: true},
+				ID:       existing.ID,
+			},
+			Files: files,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update snippet: %w", err)
+		}
+
+		report.updated++
+		return nil
+	}
+
+	_, err = processor.store.CreateSnippetTx(ctx, db.CreateSnippetTxParams{
+		CreateSnippetParams: db.CreateSnippetParams{
+			AccountID: snippetImport.AccountID,
+			Title:     file.Title,
+			Language:  file.Language,
+		},
+		Files: files,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create snippet: %w", err)
+	}
+
+	report.created++
+	return nil
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskNotifySnippetCommentType = "task:notify_snippet_comment"
+
+type PayloadNotifySnippetComment struct {
+	CommentID int64  `json:"comment_id"`
+	Recipient string `json:"recipient"`
+}
+
+// NotifySnippetCommentOptions are the options every notification is enqueued with.
+func NotifySnippetCommentOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Queue(QueueDefault),
+	}
+}
+
+// DistributeTaskNotifySnippetComment enqueues the notification about a new comment.
+func DistributeTaskNotifySnippetComment(
+	ctx context.Context,
+	distributor TaskDistributor,
+	payload *PayloadNotifySnippetComment,
+	opt ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskNotifySnippetCommentType, jsonPayload, opt...)
+}
+
+// ProcessTaskNotifySnippetComment tells the snippet owner about a new
+// comment. Comments deleted before the task runs are not notified.
+func (processor *taskHandler) ProcessTaskNotifySnippetComment(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadNotifySnippetComment
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	comment, err := processor.store.GetSnippetComment(ctx, payload.CommentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("comment doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment.DeletedAt.Valid {
+		return nil
+	}
+
+	recipient, err := processor.store.GetUser(ctx, payload.Recipient)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("recipient doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Int64("comment_id", comment.ID).
+		Int32("snippet_id", comment.SnippetID).
+		Str("author", comment.Author).
+		Str("email", recipient.Email).
+		Msg("processor task")
+
+	return nil
+}
@@ -16,8 +16,10 @@ type PayloadSendVerifyEmail struct {
 	Name string `json:"name"`
 }
 
-func (distributor *RedisTaskDistributor) DistributeTaskSendVerifyEmail(
+// DistributeTaskSendVerifyEmail enqueues the verify email of a new user.
+func DistributeTaskSendVerifyEmail(
 	ctx context.Context,
+	distributor TaskDistributor,
 	payload *PayloadSendVerifyEmail,
 	opt ...asynq.Option,
 ) error {
@@ -26,23 +28,11 @@ func (distributor *RedisTaskDistributor) DistributeTaskSendVerifyEmail(
 		return fmt.Errorf("failed to marshal task payload: %w", err)
 	}
 
-	task := asynq.NewTask(TaskSendVerifyEmailType, jsonPayload, opt...)
-	taskInfo, err := distributor.client.EnqueueContext(ctx, task)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
-
-	log.Info().Str("type", task.Type()).
-		Bytes("payload", task.Payload()).
-		Str("queue", taskInfo.Queue).
-		Int("max_retry", taskInfo.MaxRetry).
-		Msg("enqueued task")
-
-	return nil
+	return distributor.DistributeTask(ctx, TaskSendVerifyEmailType, jsonPayload, opt...)
 }
 
 // чтение из задача
-func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error {
+func (processor *taskHandler) ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error {
 	var payload PayloadSendVerifyEmail
 	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
@@ -56,7 +46,7 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to get user: %w", err)
 	}
 
-	log.Info().Str("type", task.Type()).
+	log.Ctx(ctx).Info().Str("type", task.Type()).
 		Bytes("payload", task.Payload()).
 		Str("email", user.Email).
 		Msg("processor task")
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+	"github.com/scipiia/snippetbox/requestid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/scipiia/snippetbox/worker"
+
+// traceContextKey is the payload field every task carries its trace in, the
+// typed payloads ignore it when they are decoded, like requestIDKey.
+const traceContextKey = "trace_context"
+
+type payloadTraceContext struct {
+	TraceContext map[string]string `json:"trace_context"`
+}
+
+// startEnqueueSpan starts the span of enqueueing a task and writes its trace
+// context to the payload. Without a span in ctx, like in the outbox relay,
+// the span continues the trace the payload already carries.
+func startEnqueueSpan(ctx context.Context, taskType string, payload []byte) (context.Context, trace.Span, []byte) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = extractTraceContext(ctx, payload)
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "enqueue "+taskType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("task.type", taskType)),
+	)
+
+	return ctx, span, injectTraceContext(ctx, payload)
+}
+
+// injectTraceContext adds the trace context of ctx to a JSON object payload,
+// other payloads are returned unchanged.
+func injectTraceContext(ctx context.Context, payload []byte) []byte {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return payload
+	}
+
+	return setPayloadField(payload, traceContextKey, carrier)
+}
+
+// setPayloadField sets a field of a JSON object payload, other payloads are
+// returned unchanged.
+func setPayloadField(payload []byte, key string, value interface{}) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil || fields == nil {
+		return payload
+	}
+
+	field, err := json.Marshal(value)
+	if err != nil {
+		return payload
+	}
+	fields[key] = field
+
+	result, err := json.Marshal(fields)
+	if err != nil {
+		return payload
+	}
+	return result
+}
+
+func extractTraceContext(ctx context.Context, payload []byte) context.Context {
+	var p payloadTraceContext
+	if err := json.Unmarshal(payload, &p); err != nil || len(p.TraceContext) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(p.TraceContext))
+}
+
+// spanError records err on the span and returns it.
+func spanError(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
+
+// tracingMiddleware processes every task in a span which continues the trace
+// of the request that enqueued it.
+func tracingMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		ctx = extractTraceContext(ctx, task.Payload())
+
+		ctx, span := otel.Tracer(tracerName).Start(ctx, "process "+task.Type(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				attribute.String("task.type", task.Type()),
+				attribute.String("task.id", getTaskID(ctx)),
+				attribute.String("task.queue", getQueueName(ctx)),
+				attribute.Int("task.retry_count", getRetryCount(ctx)),
+				attribute.String("request_id", requestid.FromContext(ctx)),
+			),
+		)
+		defer span.End()
+
+		err := handler.ProcessTask(ctx, task)
+		if err != nil {
+			return spanError(span, err)
+		}
+
+		return nil
+	})
+}